@@ -2,10 +2,14 @@ module github.com/wowBlaBla/go-opensea
 
 go 1.17
 
-require go.uber.org/zap v1.21.0
+require (
+	go.uber.org/zap v1.21.0
+	golang.org/x/crypto v0.14.0
+)
 
 require (
 	github.com/benbjohnson/clock v1.1.0 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
+	golang.org/x/sys v0.13.0 // indirect
 )
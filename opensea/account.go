@@ -0,0 +1,60 @@
+package opensea
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Account is an OpenSea account as embedded as owner, creator, maker, taker
+// or fee recipient in assets and orders.
+type Account struct {
+	Address       Address     `json:"address"`
+	Config        string      `json:"config"`
+	ProfileImgURL string      `json:"profile_img_url"`
+	User          AccountUser `json:"user"`
+}
+
+// AccountUser is the OpenSea user of an account. Orders nested in assets
+// only carry the numeric user ID, other responses the username.
+type AccountUser struct {
+	Username string `json:"username"`
+	ID       int64  `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts a user object or a
+// numeric user ID.
+func (u *AccountUser) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] != '{' && string(data) != "null" {
+		id, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("opensea: invalid user %s", data)
+		}
+		*u = AccountUser{ID: id}
+		return nil
+	}
+	var v accountUser
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*u = AccountUser(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. A user known only by its ID is
+// encoded as a number.
+func (u AccountUser) MarshalJSON() ([]byte, error) {
+	if u.ID != 0 && u.Username == "" {
+		return []byte(strconv.FormatInt(u.ID, 10)), nil
+	}
+	return json.Marshal(accountUser(u))
+}
+
+// accountUser has the fields of AccountUser without its methods.
+type accountUser AccountUser
+
+// Username returns the username of the account, or an empty string if it
+// has none.
+func (a Account) Username() string {
+	return a.User.Username
+}
@@ -0,0 +1,152 @@
+package opensea
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// NullAddress is the zero address, which OpenSea uses for ETH as a payment
+// token and as the sender of mints.
+const NullAddress Address = "0x0000000000000000000000000000000000000000"
+
+// Address is an Ethereum address. Addresses parsed by ParseAddress or
+// decoded from JSON are lowercase, so they can be compared with == and used
+// as map keys; Equal also handles addresses converted from other strings.
+type Address string
+
+// ParseAddress parses a hex address with 0x prefix. Mixed-case addresses
+// must have a valid EIP-55 checksum. The result is lowercase.
+func ParseAddress(s string) (Address, error) {
+	if err := validateAddress(s); err != nil {
+		return "", err
+	}
+	return Address(strings.ToLower(s)), nil
+}
+
+// MustParseAddress is like ParseAddress but panics on error.
+func MustParseAddress(s string) Address {
+	a, err := ParseAddress(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// IsValidAddress reports whether s is a valid address.
+func IsValidAddress(s string) bool {
+	return validateAddress(s) == nil
+}
+
+// Validate checks that a is a valid address.
+func (a Address) Validate() error {
+	return validateAddress(string(a))
+}
+
+// Equal reports whether a and b are the same address, ignoring case.
+func (a Address) Equal(b Address) bool {
+	return strings.EqualFold(string(a), string(b))
+}
+
+// IsZero reports whether a is empty or the zero address.
+func (a Address) IsZero() bool {
+	return a == "" || a.Equal(NullAddress)
+}
+
+// String returns a in lowercase, the form used by the OpenSea API.
+func (a Address) String() string {
+	return strings.ToLower(string(a))
+}
+
+// Hex returns a with its EIP-55 checksum, the form shown by wallets and
+// block explorers.
+func (a Address) Hex() string {
+	lower := a.String()
+	if validateAddress(lower) != nil {
+		return string(a)
+	}
+	return checksumAddress(lower)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts null and empty
+// strings as an empty Address.
+func (a *Address) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		*a = ""
+		return nil
+	}
+	v, err := ParseAddress(*s)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The address is encoded in
+// lowercase.
+func (a Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// validateAddress checks the format and, for mixed-case input, the EIP-55
+// checksum of an address.
+func validateAddress(s string) error {
+	if problem := addressProblem(s); problem != "" {
+		return fmt.Errorf("opensea: invalid address %q: %s", s, problem)
+	}
+	return nil
+}
+
+// addressProblem describes why s is not a valid address, or returns an
+// empty string if it is.
+func addressProblem(s string) string {
+	if len(s) != 42 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		return "want 0x followed by 40 hex digits"
+	}
+	digits := s[2:]
+	if _, err := hex.DecodeString(digits); err != nil {
+		return "want 0x followed by 40 hex digits"
+	}
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return ""
+	}
+	if checksumAddress(strings.ToLower(s))[2:] != digits {
+		return "bad EIP-55 checksum"
+	}
+	return ""
+}
+
+// checksumAddress returns the EIP-55 encoding of a lowercase address.
+func checksumAddress(lower string) string {
+	digits := []byte(lower[2:])
+	h := sha3.NewLegacyKeccak256()
+	h.Write(digits)
+	hash := h.Sum(nil)
+	for i, c := range digits {
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if c >= 'a' && nibble&0xf >= 8 {
+			digits[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(digits)
+}
+
+// validateAddressParam returns a ValidationError for a request parameter
+// holding an invalid address.
+func validateAddressParam(field string, a Address) error {
+	if problem := addressProblem(string(a)); problem != "" {
+		return &ValidationError{Field: field, Message: fmt.Sprintf("address %q: %s", string(a), problem)}
+	}
+	return nil
+}
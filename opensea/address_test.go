@@ -0,0 +1,161 @@
+package opensea
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Address
+		wantHex string
+		wantErr bool
+	}{
+		{
+			name:    "Checksummed",
+			s:       "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			want:    "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			wantHex: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		},
+		{
+			name:    "Lowercase",
+			s:       "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+			want:    "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+			wantHex: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		},
+		{
+			name:    "Uppercase",
+			s:       "0xDBF03B407C01E7CD3CBEA99509D93F8DDDC8C6FB",
+			want:    "0xdbf03b407c01e7cd3cbea99509d93f8dddc8c6fb",
+			wantHex: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		},
+		{
+			name:    "Bad checksum",
+			s:       "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD",
+			wantErr: true,
+		},
+		{
+			name:    "Too short",
+			s:       "0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea",
+			wantErr: true,
+		},
+		{
+			name:    "Missing prefix",
+			s:       "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			wantErr: true,
+		},
+		{
+			name:    "Not hex",
+			s:       "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaeg",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAddress(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseAddress() = %s, want %s", got, tt.want)
+			}
+			if got.Hex() != tt.wantHex {
+				t.Errorf("Address.Hex() = %s, want %s", got.Hex(), tt.wantHex)
+			}
+		})
+	}
+}
+
+func TestAddress_Equal(t *testing.T) {
+	a := Address("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	b := MustParseAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	if !a.Equal(b) {
+		t.Errorf("%s.Equal(%s) = false, want true", a, b)
+	}
+	if a.String() != string(b) {
+		t.Errorf("Address.String() = %s, want %s", a.String(), b)
+	}
+	if !NullAddress.IsZero() || !Address("").IsZero() || a.IsZero() {
+		t.Error("Address.IsZero() mismatch")
+	}
+}
+
+func TestAddress_JSON(t *testing.T) {
+	var account Account
+	if err := json.Unmarshal([]byte(`{"address": "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`), &account); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if account.Address != "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed" {
+		t.Errorf("json.Unmarshal() = %s, want lowercase", account.Address)
+	}
+
+	data, err := json.Marshal(Address("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(data) != `"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+
+	var contract AssetContract
+	if err := json.Unmarshal([]byte(`{"address": "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "payout_address": null}`), &contract); err != nil {
+		t.Fatalf("json.Unmarshal() of a null address error = %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"address": "0x1234"}`), &account); err == nil {
+		t.Error("json.Unmarshal() of an invalid address should fail")
+	}
+}
+
+func TestOpenSeaClient_InvalidAddress(t *testing.T) {
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     "http://127.0.0.1:0",
+		limitAssets: 50,
+	}
+
+	invalid := Address("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+	calls := map[string]func() error{
+		"GetAssetsWithOffset": func() error {
+			_, err := c.GetAssetsWithOffset(invalid, 0)
+			return err
+		},
+		"GetAsset": func() error {
+			_, err := c.GetAsset(invalid, TokenIDFromUint64(1), GetAssetOptions{})
+			return err
+		},
+		"GetAssetContract": func() error {
+			_, err := c.GetAssetContract(invalid)
+			return err
+		},
+		"GetCheapestOrders": func() error {
+			_, err := c.GetCheapestOrders(invalid, TokenIDFromUint64(1), "1")
+			return err
+		},
+		"GetCollections": func() error {
+			_, err := c.GetCollections(invalid)
+			return err
+		},
+		"GetEvents": func() error {
+			_, err := c.GetEvents(EventsQuery{AccountAddress: invalid})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			var validationErr *ValidationError
+			if err := call(); !errors.As(err, &validationErr) {
+				t.Errorf("error = %v, want *ValidationError", err)
+			}
+		})
+	}
+}
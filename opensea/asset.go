@@ -1,201 +1,432 @@
 package opensea
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/url"
-	"time"
 )
 
 // Asset represents an asset on OpenSea.
 // https://docs.opensea.io/reference/asset-object
 type Asset struct {
-	AnimationOriginalURL    string             `json:"animation_original_url"`
-	AnimationURL            string             `json:"animation_url"`
-	AssetContract           AssetAssetContract `json:"asset_contract"`
-	BackgroundColor         string             `json:"background_color"`
-	Collection              AssetCollection    `json:"collection"`
-	Creator                 AssetCreator       `json:"creator"`
-	Decimals                int                `json:"decimals"`
-	Description             string             `json:"description"`
-	ExternalLink            string             `json:"external_link"`
-	ID                      int                `json:"id"`
-	ImageOriginalURL        string             `json:"image_original_url"`
-	ImagePreviewURL         string             `json:"image_preview_url"`
-	ImageThumbnailURL       string             `json:"image_thumbnail_url"`
-	ImageURL                string             `json:"image_url"`
-	IsNsfw                  bool               `json:"is_nsfw"`
-	IsPresale               bool               `json:"is_presale"`
-	LastSale                AssetLastSale      `json:"last_sale"`
-	ListingDate             string             `json:"listing_date"`
-	Name                    string             `json:"name"`
-	NumSales                int                `json:"num_sales"`
-	Owner                   AssetOwner         `json:"owner"`
-	Permalink               string             `json:"permalink"`
-	SellOrders              interface{}        `json:"sell_orders"`
-	TokenID                 string             `json:"token_id"`
-	TokenMetadata           string             `json:"token_metadata"`
-	TopBid                  string             `json:"top_bid"`
-	TransferFee             string             `json:"transfer_fee"`
-	TransferFeePaymentToken string             `json:"transfer_fee_payment_token"`
-	// TODO: Support traits
-	// Traits                  []string           `json:"traits"`
-}
-
-type AssetAssetContract struct {
-	Address                     string `json:"address"`
-	AssetContractType           string `json:"asset_contract_type"`
-	BuyerFeeBasisPoints         int    `json:"buyer_fee_basis_points"`
-	CreatedDate                 string `json:"created_date"`
-	DefaultToFiat               bool   `json:"default_to_fiat"`
-	Description                 string `json:"description"`
-	DevBuyerFeeBasisPoints      int    `json:"dev_buyer_fee_basis_points"`
-	DevSellerFeeBasisPoints     int    `json:"dev_seller_fee_basis_points"`
-	ExternalLink                string `json:"external_link"`
-	ImageURL                    string `json:"image_url"`
-	Name                        string `json:"name"`
-	NftVersion                  string `json:"nft_version"`
-	OnlyProxiedTransfers        bool   `json:"only_proxied_transfers"`
-	OpenseaBuyerFeeBasisPoints  int    `json:"opensea_buyer_fee_basis_points"`
-	OpenseaSellerFeeBasisPoints int    `json:"opensea_seller_fee_basis_points"`
-	OpenseaVersion              string `json:"opensea_version"`
-	Owner                       int    `json:"owner"`
-	PayoutAddress               string `json:"payout_address"`
-	SchemaName                  string `json:"schema_name"`
-	SellerFeeBasisPoints        int    `json:"seller_fee_basis_points"`
-	Symbol                      string `json:"symbol"`
-	TotalSupply                 string `json:"total_supply"`
-}
-
-type AssetCollection struct {
-	BannerImageURL              string           `json:"banner_image_url"`
-	ChatURL                     string           `json:"chat_url"`
-	CreatedDate                 string           `json:"created_date"`
-	DefaultToFiat               bool             `json:"default_to_fiat"`
-	Description                 string           `json:"description"`
-	DevBuyerFeeBasisPoints      string           `json:"dev_buyer_fee_basis_points"`
-	DevSellerFeeBasisPoints     string           `json:"dev_seller_fee_basis_points"`
-	DiscordURL                  string           `json:"discord_url"`
-	DisplayData                 AssetDisplayData `json:"display_data"`
-	ExternalURL                 string           `json:"external_url"`
-	Featured                    bool             `json:"featured"`
-	FeaturedImageURL            string           `json:"featured_image_url"`
-	Hidden                      bool             `json:"hidden"`
-	ImageURL                    string           `json:"image_url"`
-	InstagramUsername           string           `json:"instagram_username"`
-	IsSubjectToWhitelist        bool             `json:"is_subject_to_whitelist"`
-	LargeImageURL               string           `json:"large_image_url"`
-	MediumUsername              string           `json:"medium_username"`
-	Name                        string           `json:"name"`
-	OnlyProxiedTransfers        bool             `json:"only_proxied_transfers"`
-	OpenseaBuyerFeeBasisPoints  string           `json:"opensea_buyer_fee_basis_points"`
-	OpenseaSellerFeeBasisPoints string           `json:"opensea_seller_fee_basis_points"`
-	PayoutAddress               string           `json:"payout_address"`
-	RequireEmail                bool             `json:"require_email"`
-	SafelistRequestStatus       string           `json:"safelist_request_status"`
-	ShortDescription            string           `json:"short_description"`
-	Slug                        string           `json:"slug"`
-	TelegramURL                 string           `json:"telegram_url"`
-	TwitterUsername             string           `json:"twitter_username"`
-	WikiURL                     string           `json:"wiki_url"`
-}
-
-type AssetOwner struct {
-	Address       string    `json:"address"`
-	Config        string    `json:"config"`
-	ProfileImgURL string    `json:"profile_img_url"`
-	User          AssetUser `json:"user"`
-}
-
-type AssetCreator struct {
-	Address       string    `json:"address"`
-	Config        string    `json:"config"`
-	ProfileImgURL string    `json:"profile_img_url"`
-	User          AssetUser `json:"user"`
-}
-
-type AssetUser struct {
-	Username string `json:"username"`
-}
-
-type AssetDisplayData struct {
-	CardDisplayStyle string `json:"card_display_style"`
+	AnimationOriginalURL    string           `json:"animation_original_url"`
+	AnimationURL            string           `json:"animation_url"`
+	AssetContract           AssetContract    `json:"asset_contract"`
+	BackgroundColor         string           `json:"background_color"`
+	Collection              Collection       `json:"collection"`
+	Creator                 Account          `json:"creator"`
+	Decimals                int              `json:"decimals"`
+	Description             string           `json:"description"`
+	ExternalLink            string           `json:"external_link"`
+	ID                      int              `json:"id"`
+	ImageOriginalURL        string           `json:"image_original_url"`
+	ImagePreviewURL         string           `json:"image_preview_url"`
+	ImageThumbnailURL       string           `json:"image_thumbnail_url"`
+	ImageURL                string           `json:"image_url"`
+	IsNsfw                  bool             `json:"is_nsfw"`
+	IsPresale               bool             `json:"is_presale"`
+	LastSale                AssetLastSale    `json:"last_sale"`
+	ListingDate             Timestamp        `json:"listing_date"`
+	Name                    string           `json:"name"`
+	NumSales                int              `json:"num_sales"`
+	Orders                  []Order          `json:"orders"`
+	Owner                   Account          `json:"owner"`
+	Ownership               *AssetOwnership  `json:"ownership"`
+	Permalink               string           `json:"permalink"`
+	SellOrders              []Order          `json:"sell_orders"`
+	SupportsWyvern          bool             `json:"supports_wyvern"`
+	TokenID                 TokenID          `json:"token_id"`
+	TokenMetadata           string           `json:"token_metadata"`
+	TopBid                  string           `json:"top_bid"`
+	TopOwnerships           []AssetOwnership `json:"top_ownerships"`
+	TransferFee             string           `json:"transfer_fee"`
+	TransferFeePaymentToken string           `json:"transfer_fee_payment_token"`
+	Traits                  []Trait          `json:"traits"`
 }
 
+// Deprecated: Use AssetContract.
+type AssetAssetContract = AssetContract
+
+// Deprecated: Use Collection.
+type AssetCollection = Collection
+
+// Deprecated: Use Account.
+type AssetOwner = Account
+
+// Deprecated: Use Account.
+type AssetCreator = Account
+
+// AssetOwnership is the quantity of an asset held by an account.
+type AssetOwnership struct {
+	CreatedDate Timestamp `json:"created_date"`
+	Owner       Account   `json:"owner"`
+	Quantity    string    `json:"quantity"`
+}
+
+// Deprecated: Use AccountUser.
+type AssetUser = AccountUser
+
+// Deprecated: Use CollectionDisplayData.
+type AssetDisplayData = CollectionDisplayData
+
+// AssetLastSale is the most recent sale of an asset.
 type AssetLastSale struct {
-	Asset AssetLastSaleAsset `json:"asset"`
+	Asset          AssetLastSaleAsset `json:"asset"`
+	AuctionType    string             `json:"auction_type"`
+	CreatedDate    Timestamp          `json:"created_date"`
+	EventTimestamp Timestamp          `json:"event_timestamp"`
+	EventType      EventType          `json:"event_type"`
+	PaymentToken   *PaymentToken      `json:"payment_token"`
+	Quantity       string             `json:"quantity"`
+	Seller         *Account           `json:"seller"`
+	TotalPrice     Price              `json:"total_price"`
+	Transaction    *Transaction       `json:"transaction"`
+	Winner         *Account           `json:"winner_account"`
+}
+
+// assetLastSale has the fields of AssetLastSale without its methods.
+type assetLastSale AssetLastSale
+
+// UnmarshalJSON implements json.Unmarshaler. The total price takes the
+// decimals of the payment token of the sale.
+func (s *AssetLastSale) UnmarshalJSON(data []byte) error {
+	var v assetLastSale
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = AssetLastSale(v)
+	s.TotalPrice.withDecimals(s.PaymentToken)
+	return nil
+}
+
+// TransactionHash returns the hash of the sale transaction, or an empty
+// string if it is unknown.
+func (s AssetLastSale) TransactionHash() string {
+	if s.Transaction == nil {
+		return ""
+	}
+	return s.Transaction.TransactionHash
+}
+
+// ValueETH returns the total price of the sale in ETH at the rate of its
+// payment token.
+func (s AssetLastSale) ValueETH() (*big.Rat, error) {
+	if s.PaymentToken == nil {
+		return nil, fmt.Errorf("opensea: last sale has no payment token")
+	}
+	return s.PaymentToken.ToETH(s.TotalPrice)
+}
+
+// ValueUSD returns the total price of the sale in USD at the rate of its
+// payment token.
+func (s AssetLastSale) ValueUSD() (*big.Rat, error) {
+	if s.PaymentToken == nil {
+		return nil, fmt.Errorf("opensea: last sale has no payment token")
+	}
+	return s.PaymentToken.ToUSD(s.TotalPrice)
 }
 
 type AssetLastSaleAsset struct {
-	TokenID  string `json:"token_id"`
-	Decimals int    `json:"decimals"`
+	TokenID  TokenID `json:"token_id"`
+	Decimals int     `json:"decimals"`
+}
+
+// GetAssetOptions holds the optional parameters of GetAsset.
+type GetAssetOptions struct {
+	// IncludeOrders adds the orders of the asset to Asset.Orders.
+	IncludeOrders bool
+	// AccountAddress fills Asset.Ownership with the quantity held by the account.
+	AccountAddress Address
 }
 
 type GetAssetsResponse struct {
-	Assets []Asset `json:"assets"`
+	Assets   []Asset `json:"assets"`
+	Next     string  `json:"next"`
+	Previous string  `json:"previous"`
+
+	// Filtered counts the assets dropped by the filters of the client.
+	Filtered FilterCounts `json:"-"`
+}
+
+// Values accepted by AssetsQuery.OrderBy.
+const (
+	AssetsOrderByPK        = "pk"
+	AssetsOrderBySaleDate  = "sale_date"
+	AssetsOrderBySaleCount = "sale_count"
+	AssetsOrderBySalePrice = "sale_price"
+)
+
+// Values accepted by AssetsQuery.OrderDirection.
+const (
+	OrderDirectionAsc  = "asc"
+	OrderDirectionDesc = "desc"
+)
+
+// AssetsQuery holds the filters for searching assets. Empty fields are not
+// sent to the API.
+// https://docs.opensea.io/reference/getting-assets
+type AssetsQuery struct {
+	Owner                  Address
+	Collection             string
+	AssetContractAddress   Address
+	AssetContractAddresses []Address
+	// TokenIDs requires AssetContractAddress to be set.
+	TokenIDs       []TokenID
+	OrderBy        string
+	OrderDirection string
+	IncludeOrders  bool
+	// Limit is the page size. Zero uses the page size of the client.
+	Limit int
+	// Offset is the number of assets to skip. It is only supported by
+	// PaginationOffset and cannot be combined with Cursor.
+	Offset int
+	// Cursor is the position of the page to request, taken from the Next or
+	// Previous field of a previous response.
+	Cursor string
+}
+
+// Validate checks the query for invalid values and unsupported combinations.
+func (q AssetsQuery) Validate() error {
+	if q.Owner != "" {
+		if err := validateAddressParam("owner", q.Owner); err != nil {
+			return err
+		}
+	}
+	if q.AssetContractAddress != "" {
+		if err := validateAddressParam("asset_contract_address", q.AssetContractAddress); err != nil {
+			return err
+		}
+	}
+	for _, addr := range q.AssetContractAddresses {
+		if err := validateAddressParam("asset_contract_addresses", addr); err != nil {
+			return err
+		}
+	}
+	if q.AssetContractAddress != "" && len(q.AssetContractAddresses) > 0 {
+		return &ValidationError{Field: "asset_contract_addresses", Message: "cannot be combined with asset_contract_address"}
+	}
+	if len(q.TokenIDs) > 0 && q.AssetContractAddress == "" {
+		return &ValidationError{Field: "token_ids", Message: "requires asset_contract_address"}
+	}
+	for _, id := range q.TokenIDs {
+		if !id.IsSet() {
+			return &ValidationError{Field: "token_ids", Message: "must not contain unset token IDs"}
+		}
+	}
+	if len(q.TokenIDs) > maxPageSize {
+		return &ValidationError{Field: "token_ids", Message: fmt.Sprintf("at most %d token IDs can be requested at once", maxPageSize)}
+	}
+	switch q.OrderBy {
+	case "", AssetsOrderByPK, AssetsOrderBySaleDate, AssetsOrderBySaleCount, AssetsOrderBySalePrice:
+	default:
+		return &ValidationError{Field: "order_by", Message: fmt.Sprintf("unsupported value %q", q.OrderBy)}
+	}
+	switch q.OrderDirection {
+	case "", OrderDirectionAsc, OrderDirectionDesc:
+	default:
+		return &ValidationError{Field: "order_direction", Message: fmt.Sprintf("unsupported value %q", q.OrderDirection)}
+	}
+	if q.Limit < 0 || q.Limit > maxPageSize {
+		return &ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d, or 0 for the page size of the client", maxPageSize)}
+	}
+	if q.Offset < 0 {
+		return &ValidationError{Field: "offset", Message: "must not be negative"}
+	}
+	if q.Offset > 0 && q.Cursor != "" {
+		return &ValidationError{Field: "cursor", Message: "cannot be combined with offset"}
+	}
+	return nil
+}
+
+// values encodes the query, using limit when q.Limit is not set.
+func (q AssetsQuery) values(limit int) url.Values {
+	v := url.Values{}
+	if q.Owner != "" {
+		v.Set("owner", q.Owner.String())
+	}
+	if q.Collection != "" {
+		v.Set("collection", q.Collection)
+	}
+	if q.AssetContractAddress != "" {
+		v.Set("asset_contract_address", q.AssetContractAddress.String())
+	}
+	for _, addr := range q.AssetContractAddresses {
+		v.Add("asset_contract_addresses", addr.String())
+	}
+	for _, id := range q.TokenIDs {
+		v.Add("token_ids", id.String())
+	}
+	if q.OrderBy != "" {
+		v.Set("order_by", q.OrderBy)
+	}
+	if q.OrderDirection != "" {
+		v.Set("order_direction", q.OrderDirection)
+	}
+	if q.IncludeOrders {
+		v.Set("include_orders", "true")
+	}
+	if q.Limit > 0 {
+		limit = q.Limit
+	}
+	v.Set("limit", fmt.Sprint(limit))
+	if q.Cursor != "" {
+		v.Set("cursor", q.Cursor)
+	} else {
+		v.Set("offset", fmt.Sprint(q.Offset))
+	}
+	return v
+}
+
+// GetAsset returns a single asset by contract address and token ID.
+// https://docs.opensea.io/reference/retrieving-a-single-asset
+func (c *OpenSeaClient) GetAsset(contract Address, tokenID TokenID, opts GetAssetOptions) (Asset, error) {
+	return c.GetAssetContext(context.Background(), contract, tokenID, opts)
+}
+
+// GetAssetContext is like GetAsset but bound to ctx.
+func (c *OpenSeaClient) GetAssetContext(ctx context.Context, contract Address, tokenID TokenID, opts GetAssetOptions) (Asset, error) {
+	var asset Asset
+	if err := validateAddressParam("asset_contract_address", contract); err != nil {
+		c.logf(EventError, "Error validating contract: %s", err)
+		return asset, err
+	}
+	if !tokenID.IsSet() {
+		err := &ValidationError{Field: "token_id", Message: "must be set"}
+		c.logf(EventError, "Error validating token ID: %s", err)
+		return asset, err
+	}
+	if opts.AccountAddress != "" {
+		if err := validateAddressParam("account_address", opts.AccountAddress); err != nil {
+			c.logf(EventError, "Error validating account: %s", err)
+			return asset, err
+		}
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/api/v1/asset/%s/%s/", c.baseURL, url.PathEscape(contract.String()), tokenID.String()))
+	if err != nil {
+		c.logf(EventError, "Error parsing url: %s", err)
+		return asset, err
+	}
+
+	// Set query params
+	q := u.Query()
+	if opts.IncludeOrders {
+		q.Set("include_orders", "true")
+	}
+	if opts.AccountAddress != "" {
+		q.Set("account_address", opts.AccountAddress.String())
+	}
+	u.RawQuery = q.Encode()
+
+	resp, err := c.GetContext(ctx, u)
+	if err != nil {
+		c.logf(EventError, "Error getting asset: %s", err)
+		return asset, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&asset)
+	if err != nil {
+		c.logf(EventError, "Error decoding response: %s", err)
+		return asset, err
+	}
+
+	return asset, nil
 }
 
 // GetAssetsWithOffset gets a list of assets with an offset
 // https://docs.opensea.io/reference/getting-assets
-func (c *OpenSeaClient) GetAssetsWithOffset(owner string, offset int) (GetAssetsResponse, error) {
+func (c *OpenSeaClient) GetAssetsWithOffset(owner Address, offset int) (GetAssetsResponse, error) {
+	return c.GetAssetsWithOffsetContext(context.Background(), owner, offset)
+}
+
+// GetAssetsWithOffsetContext is like GetAssetsWithOffset but bound to ctx.
+func (c *OpenSeaClient) GetAssetsWithOffsetContext(ctx context.Context, owner Address, offset int) (GetAssetsResponse, error) {
+	if err := validateAddressParam("owner", owner); err != nil {
+		c.logf(EventError, "Error validating owner: %s", err)
+		return GetAssetsResponse{}, err
+	}
+	return c.SearchAssetsContext(ctx, AssetsQuery{Owner: owner, Offset: offset})
+}
+
+// SearchAssets gets a single page of assets matching the query.
+// https://docs.opensea.io/reference/getting-assets
+func (c *OpenSeaClient) SearchAssets(query AssetsQuery) (GetAssetsResponse, error) {
+	return c.SearchAssetsContext(context.Background(), query)
+}
+
+// SearchAssetsContext is like SearchAssets but bound to ctx.
+func (c *OpenSeaClient) SearchAssetsContext(ctx context.Context, query AssetsQuery) (GetAssetsResponse, error) {
 	var osResp GetAssetsResponse
+	if err := query.Validate(); err != nil {
+		c.logf(EventError, "Error validating query: %s", err)
+		return osResp, err
+	}
+
 	u, err := url.Parse(fmt.Sprintf("%s/api/v1/assets", c.baseURL))
 	if err != nil {
-		c.Log.Errorf("Error parsing url: %s", err)
+		c.logf(EventError, "Error parsing url: %s", err)
 		return osResp, err
 	}
 
 	// Set query params
-	q := u.Query()
-	q.Set("owner", owner)
-	q.Set("limit", fmt.Sprint(c.limitAssets))
-	q.Set("offset", fmt.Sprint(offset))
-	u.RawQuery = q.Encode()
+	u.RawQuery = query.values(c.limitAssets).Encode()
 
-	resp, err := c.Get(u)
+	resp, err := c.GetContext(ctx, u)
 	if err != nil {
-		c.Log.Errorf("Error getting assets: %s", err)
+		c.logf(EventError, "Error getting assets: %s", err)
 		return osResp, err
 	}
 	defer resp.Body.Close()
 
 	err = json.NewDecoder(resp.Body).Decode(&osResp)
 	if err != nil {
-		c.Log.Errorf("Error decoding response: %s", err)
+		c.logf(EventError, "Error decoding response: %s", err)
 		return osResp, err
 	}
 
-	// TODO: Filter out assets with hidden collections
-	// var filtered = []Asset{}
-	// for _, asset := range osResp.Assets {
-	// 	if !asset.Collection.Hidden {
-	// 		filtered = append(filtered, asset)
-	// 	}
-	// }
+	osResp.Assets, osResp.Filtered = c.filterAssets(osResp.Assets)
 
 	return osResp, nil
 }
 
 // GetAssets returns the assets for an address
-func (c *OpenSeaClient) GetAssets(address string) ([]Asset, error) {
-	var (
-		allAssets []Asset
-		offset    int
-	)
-
-	for {
-		resp, err := c.GetAssetsWithOffset(address, offset)
+func (c *OpenSeaClient) GetAssets(address Address) ([]Asset, error) {
+	return c.GetAssetsContext(context.Background(), address)
+}
+
+// GetAssetsContext is like GetAssets but bound to ctx. Cancelling ctx stops
+// the pagination and returns the assets collected so far with ctx.Err().
+func (c *OpenSeaClient) GetAssetsContext(ctx context.Context, address Address) ([]Asset, error) {
+	if err := validateAddressParam("owner", address); err != nil {
+		c.logf(EventError, "Error validating owner: %s", err)
+		return nil, err
+	}
+	return c.SearchAllAssetsContext(ctx, AssetsQuery{Owner: address})
+}
+
+// SearchAllAssets returns all assets matching the query, following the
+// pages from query.Cursor or query.Offset on. The number of assets dropped
+// by the filters of the client is reported by AssetsPaginator.Filtered and
+// AssetIterator.Filtered.
+func (c *OpenSeaClient) SearchAllAssets(query AssetsQuery) ([]Asset, error) {
+	return c.SearchAllAssetsContext(context.Background(), query)
+}
+
+// SearchAllAssetsContext is like SearchAllAssets but bound to ctx.
+func (c *OpenSeaClient) SearchAllAssetsContext(ctx context.Context, query AssetsQuery) ([]Asset, error) {
+	var allAssets []Asset
+
+	p := c.NewAssetsPaginator(query)
+	for p.HasNextPage() {
+		resp, err := p.NextPage(ctx)
 		if err != nil {
 			return allAssets, err
 		}
 
-		if len(resp.Assets) == 0 {
-			break
-		}
-
 		allAssets = append(allAssets, resp.Assets...)
-		offset += c.limitAssets
-		time.Sleep(c.requestDelay)
 	}
 
 	return allAssets, nil
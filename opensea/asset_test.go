@@ -1,6 +1,9 @@
 package opensea
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -9,21 +12,20 @@ import (
 	"testing"
 	"time"
 
-	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 )
 
 func TestOpenSeaClient_GetAssetsWithOffset(t *testing.T) {
 	type fields struct {
-		Log          *zap.SugaredLogger
-		apiKey       string
-		client       *http.Client
-		baseURL      string
-		limitAssets  int
-		requestDelay time.Duration
+		Log         Logger
+		apiKey      string
+		client      *http.Client
+		baseURL     string
+		limitAssets int
+		Limiter     *RateLimiter
 	}
 	type args struct {
-		owner  string
+		owner  Address
 		offset int
 	}
 	tests := []struct {
@@ -38,12 +40,12 @@ func TestOpenSeaClient_GetAssetsWithOffset(t *testing.T) {
 		{
 			name: "Get assets with offset",
 			fields: fields{
-				Log:          zaptest.NewLogger(t).Sugar(),
-				apiKey:       "",
-				client:       &http.Client{},
-				baseURL:      "https://api.opensea.io",
-				limitAssets:  50,
-				requestDelay: time.Millisecond * 250,
+				Log:         zaptest.NewLogger(t).Sugar(),
+				apiKey:      "",
+				client:      &http.Client{},
+				baseURL:     "https://api.opensea.io",
+				limitAssets: 50,
+				Limiter:     NewRateLimiter(4, 1),
 			},
 			args: args{
 				owner:  "0x3b417FaeE9d2ff636701100891DC2755b5321Cc3",
@@ -60,8 +62,8 @@ func TestOpenSeaClient_GetAssetsWithOffset(t *testing.T) {
 				if r.URL.Path != tt.path {
 					t.Errorf("Expected to request '%s', got: %s", tt.path, r.URL.Path)
 				}
-				if r.Header.Get("Content-Type") != "application/json" {
-					t.Errorf("Expected Content-Type: application/json header, got: %s", r.Header.Get("Accept"))
+				if r.Header.Get("Accept") != "application/json" {
+					t.Errorf("Expected Accept: application/json header, got: %s", r.Header.Get("Accept"))
 				}
 				w.WriteHeader(http.StatusOK)
 
@@ -82,12 +84,12 @@ func TestOpenSeaClient_GetAssetsWithOffset(t *testing.T) {
 			defer server.Close()
 
 			c := &OpenSeaClient{
-				Log:          tt.fields.Log,
-				apiKey:       tt.fields.apiKey,
-				client:       tt.fields.client,
-				baseURL:      server.URL,
-				limitAssets:  tt.fields.limitAssets,
-				requestDelay: tt.fields.requestDelay,
+				Log:         tt.fields.Log,
+				apiKey:      tt.fields.apiKey,
+				client:      tt.fields.client,
+				baseURL:     server.URL,
+				Limiter:     tt.fields.Limiter,
+				limitAssets: tt.fields.limitAssets,
 			}
 			got, err := c.GetAssetsWithOffset(tt.args.owner, tt.args.offset)
 			if (err != nil) != tt.wantErr {
@@ -100,3 +102,379 @@ func TestOpenSeaClient_GetAssetsWithOffset(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenSeaClient_GetAsset(t *testing.T) {
+	type fields struct {
+		Log         Logger
+		apiKey      string
+		client      *http.Client
+		baseURL     string
+		limitAssets int
+		Limiter     *RateLimiter
+	}
+	type args struct {
+		contract Address
+		tokenID  TokenID
+		opts     GetAssetOptions
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		path        string
+		query       string
+		fixturePath string
+		want        Asset
+		wantErr     bool
+	}{
+		{
+			name: "Get asset with orders and ownership",
+			fields: fields{
+				Log:         zaptest.NewLogger(t).Sugar(),
+				apiKey:      "",
+				client:      &http.Client{},
+				baseURL:     "https://api.opensea.io",
+				limitAssets: 50,
+				Limiter:     NewRateLimiter(4, 1),
+			},
+			args: args{
+				contract: "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+				tokenID:  MustParseTokenID("681954"),
+				opts: GetAssetOptions{
+					IncludeOrders:  true,
+					AccountAddress: "0x3b417FaeE9d2ff636701100891DC2755b5321Cc3",
+				},
+			},
+			path:        "/api/v1/asset/0xd07dc4262bcdbf85190c01c996b4c06a461d2430/681954/",
+			query:       "account_address=0x3b417faee9d2ff636701100891dc2755b5321cc3&include_orders=true",
+			fixturePath: "../testdata/get_asset.json",
+			want:        FixtureGetAssetResp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("Expected to request '%s', got: %s", tt.path, r.URL.Path)
+				}
+				if r.URL.RawQuery != tt.query {
+					t.Errorf("Expected query '%s', got: %s", tt.query, r.URL.RawQuery)
+				}
+				if r.Header.Get("Accept") != "application/json" {
+					t.Errorf("Expected Accept: application/json header, got: %s", r.Header.Get("Accept"))
+				}
+				w.WriteHeader(http.StatusOK)
+
+				// Read the fixture
+				jsonFile, err := os.Open(tt.fixturePath)
+				if err != nil {
+					t.Errorf("Failed to open fixture file: %s", err)
+				}
+				defer jsonFile.Close()
+
+				// Write the fixture to the response
+				jsonFile.Seek(0, 0)
+				_, err = io.Copy(w, jsonFile)
+				if err != nil {
+					t.Errorf("Failed to write fixture to response: %s", err)
+				}
+			}))
+			defer server.Close()
+
+			c := &OpenSeaClient{
+				Log:         tt.fields.Log,
+				apiKey:      tt.fields.apiKey,
+				client:      tt.fields.client,
+				baseURL:     server.URL,
+				Limiter:     tt.fields.Limiter,
+				limitAssets: tt.fields.limitAssets,
+			}
+			got, err := c.GetAsset(tt.args.contract, tt.args.tokenID, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OpenSeaClient.GetAsset() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OpenSeaClient.GetAsset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSeaClient_GetAssetsContext_Cancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"assets": [{"id": 1}]}`))
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		Limiter:     NewRateLimiter(1.0/3600, 1),
+		limitAssets: 1,
+		pagination:  PaginationOffset,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	got, err := c.GetAssetsContext(ctx, "0x3b417FaeE9d2ff636701100891DC2755b5321Cc3")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("OpenSeaClient.GetAssetsContext() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if len(got) != 1 {
+		t.Errorf("OpenSeaClient.GetAssetsContext() returned %d assets, want 1", len(got))
+	}
+}
+
+func TestAssetsQuery_Values(t *testing.T) {
+	tests := []struct {
+		name  string
+		query AssetsQuery
+		want  string
+	}{
+		{
+			name:  "Owner",
+			query: AssetsQuery{Owner: "0x3b417FaeE9d2ff636701100891DC2755b5321Cc3", Offset: 50},
+			want:  "limit=50&offset=50&owner=0x3b417faee9d2ff636701100891dc2755b5321cc3",
+		},
+		{
+			name: "Collection ordered by sale price",
+			query: AssetsQuery{
+				Collection:     "boredapeyachtclub",
+				OrderBy:        AssetsOrderBySalePrice,
+				OrderDirection: OrderDirectionDesc,
+				IncludeOrders:  true,
+				Limit:          20,
+			},
+			want: "collection=boredapeyachtclub&include_orders=true&limit=20&offset=0&order_by=sale_price&order_direction=desc",
+		},
+		{
+			name: "Token IDs of a contract",
+			query: AssetsQuery{
+				AssetContractAddress: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+				TokenIDs:             []TokenID{TokenIDFromUint64(1), TokenIDFromUint64(2), TokenIDFromUint64(3)},
+			},
+			want: "asset_contract_address=0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d&limit=50&offset=0&token_ids=1&token_ids=2&token_ids=3",
+		},
+		{
+			name: "Several contracts",
+			query: AssetsQuery{
+				AssetContractAddresses: []Address{"0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", "0x60e4d786628fea6478f785a6d7e704777c86a7c6"},
+			},
+			want: "asset_contract_addresses=0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d&asset_contract_addresses=0x60e4d786628fea6478f785a6d7e704777c86a7c6&limit=50&offset=0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.query.Validate(); err != nil {
+				t.Fatalf("AssetsQuery.Validate() error = %v", err)
+			}
+			if got := tt.query.values(50).Encode(); got != tt.want {
+				t.Errorf("AssetsQuery.values() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssetsQuery_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     AssetsQuery
+		wantField string
+	}{
+		{
+			name:      "Token IDs without contract",
+			query:     AssetsQuery{Collection: "boredapeyachtclub", TokenIDs: []TokenID{TokenIDFromUint64(1)}},
+			wantField: "token_ids",
+		},
+		{
+			name: "Single and multiple contracts",
+			query: AssetsQuery{
+				AssetContractAddress:   "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+				AssetContractAddresses: []Address{"0x60e4d786628fea6478f785a6d7e704777c86a7c6"},
+			},
+			wantField: "asset_contract_addresses",
+		},
+		{
+			name:      "Unknown ordering",
+			query:     AssetsQuery{OrderBy: "name"},
+			wantField: "order_by",
+		},
+		{
+			name:      "Unknown direction",
+			query:     AssetsQuery{OrderDirection: "up"},
+			wantField: "order_direction",
+		},
+		{
+			name:      "Limit too large",
+			query:     AssetsQuery{Limit: 100},
+			wantField: "limit",
+		},
+		{
+			name:      "Negative offset",
+			query:     AssetsQuery{Offset: -1},
+			wantField: "offset",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("AssetsQuery.Validate() error = %v, want *ValidationError", err)
+			}
+			if validationErr.Field != tt.wantField {
+				t.Errorf("ValidationError.Field = %s, want %s", validationErr.Field, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestOpenSeaClient_GetAssets_EmptyOwner(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s", r.URL)
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+	}
+	var validationErr *ValidationError
+	if _, err := c.GetAssets(""); !errors.As(err, &validationErr) || validationErr.Field != "owner" {
+		t.Errorf("OpenSeaClient.GetAssets(\"\") error = %v, want owner *ValidationError", err)
+	}
+	if _, err := c.GetAssetsWithOffset("", 0); !errors.As(err, &validationErr) || validationErr.Field != "owner" {
+		t.Errorf("OpenSeaClient.GetAssetsWithOffset(\"\") error = %v, want owner *ValidationError", err)
+	}
+}
+
+func TestOpenSeaClient_SearchAllAssets(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("offset") == "0" {
+			w.Write([]byte(`{"assets": [{"id": 1}, {"id": 2}]}`))
+			return
+		}
+		w.Write([]byte(`{"assets": []}`))
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+		pagination:  PaginationOffset,
+	}
+	got, err := c.SearchAllAssets(AssetsQuery{Collection: "boredapeyachtclub", Limit: 2})
+	if err != nil {
+		t.Fatalf("OpenSeaClient.SearchAllAssets() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("OpenSeaClient.SearchAllAssets() returned %d assets, want 2", len(got))
+	}
+	want := []string{
+		"collection=boredapeyachtclub&limit=2&offset=0",
+		"collection=boredapeyachtclub&limit=2&offset=2",
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("requested %q, want %q", queries, want)
+	}
+}
+
+func TestOpenSeaClient_SearchAllAssetsCursor(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"assets": [{"id": 1}, {"id": 2}], "next": "LXBrPTI=", "previous": null}`))
+		case "LXBrPTI=":
+			w.Write([]byte(`{"assets": [{"id": 3}], "next": null, "previous": "LXBrPTE="}`))
+		default:
+			t.Errorf("Unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 2,
+	}
+	got, err := c.SearchAllAssets(AssetsQuery{Owner: "0x3b417FaeE9d2ff636701100891DC2755b5321Cc3"})
+	if err != nil {
+		t.Fatalf("OpenSeaClient.SearchAllAssets() error = %v", err)
+	}
+	if len(got) != 3 {
+		t.Errorf("OpenSeaClient.SearchAllAssets() returned %d assets, want 3", len(got))
+	}
+	want := []string{
+		"limit=2&offset=0&owner=0x3b417faee9d2ff636701100891dc2755b5321cc3",
+		"cursor=LXBrPTI%3D&limit=2&owner=0x3b417faee9d2ff636701100891dc2755b5321cc3",
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("requested %q, want %q", queries, want)
+	}
+}
+
+func TestAssetLastSale(t *testing.T) {
+	sale := FixtureGetAssetResp.LastSale
+	if got := sale.TotalPrice.Decimals; got != 18 {
+		t.Errorf("AssetLastSale.TotalPrice.Decimals = %d, want 18", got)
+	}
+	usd, err := sale.ValueUSD()
+	if err != nil {
+		t.Fatalf("AssetLastSale.ValueUSD() error = %v", err)
+	}
+	if got := usd.FloatString(4); got != "146.2554" {
+		t.Errorf("AssetLastSale.ValueUSD() = %s, want 146.2554", got)
+	}
+	if got := sale.TransactionHash(); got != "0x8f1a1c7e3f6b2d4a9c5e0b7d1f3a5c7e9b2d4f6a8c0e1b3d5f7a9c2e4b6d8f0a" {
+		t.Errorf("AssetLastSale.TransactionHash() = %s", got)
+	}
+	if _, err := (AssetLastSale{}).ValueUSD(); err == nil {
+		t.Error("AssetLastSale.ValueUSD() without a payment token should fail")
+	}
+}
+
+func TestAsset_SellOrders(t *testing.T) {
+	orders := FixtureGetAssetResp.SellOrders
+	if len(orders) != 1 {
+		t.Fatalf("Asset.SellOrders has %d orders, want 1", len(orders))
+	}
+	if got := orders[0].Taker.User.ID; got != 1766 {
+		t.Errorf("Order.Taker.User.ID = %d, want 1766", got)
+	}
+
+	data, err := json.Marshal(orders[0])
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var got Order
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, orders[0]) {
+		t.Errorf("round trip of sell order = %+v, want %+v", got, orders[0])
+	}
+}
+
+func TestAssetsQuery_ValidateLimit(t *testing.T) {
+	if err := (AssetsQuery{Limit: 0}).Validate(); err != nil {
+		t.Errorf("AssetsQuery.Validate() with the default limit error = %v", err)
+	}
+	err := AssetsQuery{Limit: 51}.Validate()
+	want := "opensea: invalid limit: must be between 1 and 50, or 0 for the page size of the client"
+	if err == nil || err.Error() != want {
+		t.Errorf("AssetsQuery.Validate() error = %v, want %s", err, want)
+	}
+}
@@ -0,0 +1,68 @@
+package opensea
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// BasisPoints is a fee in hundredths of a percent. OpenSea encodes fees as
+// numbers on contracts and as strings on collections; BasisPoints decodes
+// both and always encodes as a number.
+type BasisPoints int
+
+// ParseBasisPoints parses a decimal number of basis points.
+func ParseBasisPoints(s string) (BasisPoints, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("opensea: invalid basis points %q", s)
+	}
+	return BasisPoints(n), nil
+}
+
+// Percent returns the fee as a percentage, e.g. 2.5 for 250 basis points.
+func (b BasisPoints) Percent() float64 {
+	return float64(b) / 100
+}
+
+// Fraction returns the fee as a fraction, e.g. 0.025 for 250 basis points.
+func (b BasisPoints) Fraction() float64 {
+	return float64(b) / 10000
+}
+
+func (b BasisPoints) String() string {
+	return strconv.Itoa(int(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts numbers, numeric
+// strings, empty strings and null.
+func (b *BasisPoints) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*b = 0
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*b = 0
+			return nil
+		}
+		v, err := ParseBasisPoints(s)
+		if err != nil {
+			return err
+		}
+		*b = v
+		return nil
+	}
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("opensea: invalid basis points %s", data)
+	}
+	*b = BasisPoints(n)
+	return nil
+}
@@ -0,0 +1,73 @@
+package opensea
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBasisPoints_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    BasisPoints
+		wantErr bool
+	}{
+		{name: "Number", data: `250`, want: 250},
+		{name: "String", data: `"250"`, want: 250},
+		{name: "Empty string", data: `""`, want: 0},
+		{name: "Null", data: `null`, want: 0},
+		{name: "Invalid string", data: `"2.5%"`, wantErr: true},
+		{name: "Invalid type", data: `true`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got BasisPoints
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BasisPoints.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BasisPoints.UnmarshalJSON() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBasisPoints_MarshalJSON(t *testing.T) {
+	got, err := json.Marshal(AssetContract{SellerFeeBasisPoints: 250}.SellerFeeBasisPoints)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(got) != "250" {
+		t.Errorf("json.Marshal() = %s, want 250", got)
+	}
+	if p := BasisPoints(250).Percent(); p != 2.5 {
+		t.Errorf("BasisPoints.Percent() = %v, want 2.5", p)
+	}
+	if f := BasisPoints(250).Fraction(); f != 0.025 {
+		t.Errorf("BasisPoints.Fraction() = %v, want 0.025", f)
+	}
+}
+
+func TestCollection_Contract(t *testing.T) {
+	collection := FixtureGetCollectionResp
+	primary, ok := collection.PrimaryContract()
+	if !ok {
+		t.Fatal("Collection.PrimaryContract() returned false")
+	}
+
+	// Contracts embedded in collections and assets share one type, so fees
+	// decoded from either encoding compare equal.
+	if primary.OpenseaSellerFeeBasisPoints != collection.OpenseaSellerFeeBasisPoints {
+		t.Errorf("OpenseaSellerFeeBasisPoints = %d, want %d", primary.OpenseaSellerFeeBasisPoints, collection.OpenseaSellerFeeBasisPoints)
+	}
+
+	got, ok := collection.Contract(Address(primary.Address.Hex()))
+	if !ok || got.Address != primary.Address {
+		t.Errorf("Collection.Contract() = %v, %v, want %s", got.Address, ok, primary.Address)
+	}
+	if _, ok := (Collection{}).PrimaryContract(); ok {
+		t.Error("Collection.PrimaryContract() of empty collection returned true")
+	}
+}
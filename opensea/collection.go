@@ -1,84 +1,64 @@
 package opensea
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 )
 
 type Collection struct {
-	Editors                     []string                          `json:"editors"`
-	PaymentTokens               []CollectionPaymentTokens         `json:"payment_tokens"`
-	PrimaryAssetContracts       []CollectionPrimaryAssetContracts `json:"primary_asset_contracts"`
-	Stats                       CollectionStats                   `json:"stats"`
-	BannerImageURL              string                            `json:"banner_image_url"`
-	ChatURL                     string                            `json:"chat_url"`
-	CreatedDate                 string                            `json:"created_date"`
-	DefaultToFiat               bool                              `json:"default_to_fiat"`
-	Description                 string                            `json:"description"`
-	DevBuyerFeeBasisPoints      string                            `json:"dev_buyer_fee_basis_points"`
-	DevSellerFeeBasisPoints     string                            `json:"dev_seller_fee_basis_points"`
-	DiscordURL                  string                            `json:"discord_url"`
-	DisplayData                 CollectionDisplayData             `json:"display_data"`
-	ExternalURL                 string                            `json:"external_url"`
-	Featured                    bool                              `json:"featured"`
-	FeaturedImageURL            string                            `json:"featured_image_url"`
-	Hidden                      bool                              `json:"hidden"`
-	SafelistRequestStatus       string                            `json:"safelist_request_status"`
-	ImageURL                    string                            `json:"image_url"`
-	IsSubjectToWhitelist        bool                              `json:"is_subject_to_whitelist"`
-	LargeImageURL               string                            `json:"large_image_url"`
-	MediumUsername              string                            `json:"medium_username"`
-	Name                        string                            `json:"name"`
-	OnlyProxiedTransfers        bool                              `json:"only_proxied_transfers"`
-	OpenseaBuyerFeeBasisPoints  string                            `json:"opensea_buyer_fee_basis_points"`
-	OpenseaSellerFeeBasisPoints string                            `json:"opensea_seller_fee_basis_points"`
-	PayoutAddress               string                            `json:"payout_address"`
-	RequireEmail                bool                              `json:"require_email"`
-	ShortDescription            string                            `json:"short_description"`
-	Slug                        string                            `json:"slug"`
-	TelegramURL                 string                            `json:"telegram_url"`
-	TwitterUsername             string                            `json:"twitter_username"`
-	InstagramUsername           string                            `json:"instagram_username"`
-	WikiURL                     string                            `json:"wiki_url"`
-}
-
-type CollectionPaymentTokens struct {
-	ID       int     `json:"id"`
-	Symbol   string  `json:"symbol"`
-	Address  string  `json:"address"`
-	ImageURL string  `json:"image_url"`
-	Name     string  `json:"name"`
-	Decimals int     `json:"decimals"`
-	EthPrice float64 `json:"eth_price"`
-	UsdPrice float64 `json:"usd_price"`
-}
-
-type CollectionPrimaryAssetContracts struct {
-	Address                     string `json:"address"`
-	AssetContractType           string `json:"asset_contract_type"`
-	CreatedDate                 string `json:"created_date"`
-	Name                        string `json:"name"`
-	NftVersion                  string `json:"nft_version"`
-	OpenseaVersion              string `json:"opensea_version"`
-	Owner                       int    `json:"owner"`
-	SchemaName                  string `json:"schema_name"`
-	Symbol                      string `json:"symbol"`
-	TotalSupply                 string `json:"total_supply"`
-	Description                 string `json:"description"`
-	ExternalLink                string `json:"external_link"`
-	ImageURL                    string `json:"image_url"`
-	DefaultToFiat               bool   `json:"default_to_fiat"`
-	DevBuyerFeeBasisPoints      int    `json:"dev_buyer_fee_basis_points"`
-	DevSellerFeeBasisPoints     int    `json:"dev_seller_fee_basis_points"`
-	OnlyProxiedTransfers        bool   `json:"only_proxied_transfers"`
-	OpenseaBuyerFeeBasisPoints  int    `json:"opensea_buyer_fee_basis_points"`
-	OpenseaSellerFeeBasisPoints int    `json:"opensea_seller_fee_basis_points"`
-	BuyerFeeBasisPoints         int    `json:"buyer_fee_basis_points"`
-	SellerFeeBasisPoints        int    `json:"seller_fee_basis_points"`
-	PayoutAddress               string `json:"payout_address"`
+	Editors                     []string              `json:"editors"`
+	PaymentTokens               []PaymentToken        `json:"payment_tokens"`
+	PrimaryAssetContracts       []AssetContract       `json:"primary_asset_contracts"`
+	Stats                       CollectionStats       `json:"stats"`
+	BannerImageURL              string                `json:"banner_image_url"`
+	ChatURL                     string                `json:"chat_url"`
+	CreatedDate                 Timestamp             `json:"created_date"`
+	DefaultToFiat               bool                  `json:"default_to_fiat"`
+	Description                 string                `json:"description"`
+	DevBuyerFeeBasisPoints      BasisPoints           `json:"dev_buyer_fee_basis_points"`
+	DevSellerFeeBasisPoints     BasisPoints           `json:"dev_seller_fee_basis_points"`
+	DiscordURL                  string                `json:"discord_url"`
+	DisplayData                 CollectionDisplayData `json:"display_data"`
+	ExternalURL                 string                `json:"external_url"`
+	Featured                    bool                  `json:"featured"`
+	FeaturedImageURL            string                `json:"featured_image_url"`
+	Hidden                      bool                  `json:"hidden"`
+	SafelistRequestStatus       string                `json:"safelist_request_status"`
+	ImageURL                    string                `json:"image_url"`
+	IsSubjectToWhitelist        bool                  `json:"is_subject_to_whitelist"`
+	LargeImageURL               string                `json:"large_image_url"`
+	MediumUsername              string                `json:"medium_username"`
+	Name                        string                `json:"name"`
+	OnlyProxiedTransfers        bool                  `json:"only_proxied_transfers"`
+	OpenseaBuyerFeeBasisPoints  BasisPoints           `json:"opensea_buyer_fee_basis_points"`
+	OpenseaSellerFeeBasisPoints BasisPoints           `json:"opensea_seller_fee_basis_points"`
+	PayoutAddress               Address               `json:"payout_address"`
+	RequireEmail                bool                  `json:"require_email"`
+	ShortDescription            string                `json:"short_description"`
+	Slug                        string                `json:"slug"`
+	TelegramURL                 string                `json:"telegram_url"`
+	TwitterUsername             string                `json:"twitter_username"`
+	InstagramUsername           string                `json:"instagram_username"`
+	WikiURL                     string                `json:"wiki_url"`
+	OwnedAssetCount             int64                 `json:"owned_asset_count"`
 }
 
+// Values of Collection.SafelistRequestStatus.
+const (
+	SafelistNotRequested = "not_requested"
+	SafelistRequested    = "requested"
+	SafelistApproved     = "approved"
+	SafelistVerified     = "verified"
+)
+
+// Deprecated: Use PaymentToken.
+type CollectionPaymentTokens = PaymentToken
+
+// Deprecated: Use AssetContract.
+type CollectionPrimaryAssetContracts = AssetContract
+
 type CollectionStats struct {
 	OneDayVolume          float64 `json:"one_day_volume"`
 	OneDayChange          float64 `json:"one_day_change"`
@@ -111,18 +91,29 @@ type GetCollectionResponse struct {
 	Collection Collection `json:"collection"`
 }
 
+type GetCollectionStatsResponse struct {
+	Stats CollectionStats `json:"stats"`
+}
+
+// GetCollection returns a single collection by its slug.
+// https://docs.opensea.io/reference/retrieving-a-single-collection
 func (c *OpenSeaClient) GetCollection(slug string) (Collection, error) {
+	return c.GetCollectionContext(context.Background(), slug)
+}
+
+// GetCollectionContext is like GetCollection but bound to ctx.
+func (c *OpenSeaClient) GetCollectionContext(ctx context.Context, slug string) (Collection, error) {
 	var collection Collection
 
 	u, err := url.Parse(fmt.Sprintf("%s/api/v1/collection/%s", c.baseURL, slug))
 	if err != nil {
-		c.Log.Errorf("Error parsing url: %s", err)
+		c.logf(EventError, "Error parsing url: %s", err)
 		return collection, err
 	}
 
-	resp, err := c.Get(u)
+	resp, err := c.GetContext(ctx, u)
 	if err != nil {
-		c.Log.Errorf("Error getting collection: %s", err)
+		c.logf(EventError, "Error getting collection: %s", err)
 		return collection, err
 	}
 
@@ -131,9 +122,140 @@ func (c *OpenSeaClient) GetCollection(slug string) (Collection, error) {
 	var osResp GetCollectionResponse
 	err = json.NewDecoder(resp.Body).Decode(&osResp)
 	if err != nil {
-		c.Log.Errorf("Error decoding response: %s", err)
+		c.logf(EventError, "Error decoding response: %s", err)
 		return collection, err
 	}
 
 	return osResp.Collection, nil
 }
+
+// GetCollectionStats returns the stats of a collection without the rest of
+// the collection.
+// https://docs.opensea.io/reference/retrieving-collection-stats
+func (c *OpenSeaClient) GetCollectionStats(slug string) (CollectionStats, error) {
+	return c.GetCollectionStatsContext(context.Background(), slug)
+}
+
+// GetCollectionStatsContext is like GetCollectionStats but bound to ctx.
+func (c *OpenSeaClient) GetCollectionStatsContext(ctx context.Context, slug string) (CollectionStats, error) {
+	var stats CollectionStats
+	if slug == "" {
+		err := &ValidationError{Field: "slug", Message: "must not be empty"}
+		c.logf(EventError, "Error validating slug: %s", err)
+		return stats, err
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/api/v1/collection/%s/stats", c.baseURL, url.PathEscape(slug)))
+	if err != nil {
+		c.logf(EventError, "Error parsing url: %s", err)
+		return stats, err
+	}
+
+	resp, err := c.GetContext(ctx, u)
+	if err != nil {
+		c.logf(EventError, "Error getting collection stats: %s", err)
+		return stats, err
+	}
+	defer resp.Body.Close()
+
+	var osResp GetCollectionStatsResponse
+	err = json.NewDecoder(resp.Body).Decode(&osResp)
+	if err != nil {
+		c.logf(EventError, "Error decoding response: %s", err)
+		return stats, err
+	}
+
+	return osResp.Stats, nil
+}
+
+// GetCollectionsWithOffset gets a page of the collections in which owner holds assets.
+// https://docs.opensea.io/reference/retrieving-collections
+func (c *OpenSeaClient) GetCollectionsWithOffset(owner Address, offset int) ([]Collection, error) {
+	return c.GetCollectionsWithOffsetContext(context.Background(), owner, offset)
+}
+
+// GetCollectionsWithOffsetContext is like GetCollectionsWithOffset but bound to ctx.
+func (c *OpenSeaClient) GetCollectionsWithOffsetContext(ctx context.Context, owner Address, offset int) ([]Collection, error) {
+	var collections []Collection
+	if err := validateAddressParam("asset_owner", owner); err != nil {
+		c.logf(EventError, "Error validating owner: %s", err)
+		return collections, err
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/api/v1/collections", c.baseURL))
+	if err != nil {
+		c.logf(EventError, "Error parsing url: %s", err)
+		return collections, err
+	}
+
+	// Set query params
+	q := u.Query()
+	q.Set("asset_owner", owner.String())
+	q.Set("limit", fmt.Sprint(c.limitAssets))
+	q.Set("offset", fmt.Sprint(offset))
+	u.RawQuery = q.Encode()
+
+	resp, err := c.GetContext(ctx, u)
+	if err != nil {
+		c.logf(EventError, "Error getting collections: %s", err)
+		return collections, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&collections)
+	if err != nil {
+		c.logf(EventError, "Error decoding response: %s", err)
+		return collections, err
+	}
+
+	return collections, nil
+}
+
+// GetCollections returns all collections in which owner holds assets, with
+// Collection.OwnedAssetCount set.
+func (c *OpenSeaClient) GetCollections(owner Address) ([]Collection, error) {
+	return c.GetCollectionsContext(context.Background(), owner)
+}
+
+// GetCollectionsContext is like GetCollections but bound to ctx.
+func (c *OpenSeaClient) GetCollectionsContext(ctx context.Context, owner Address) ([]Collection, error) {
+	var allCollections []Collection
+
+	p := c.NewCollectionsPaginator(owner)
+	for p.HasNextPage() {
+		collections, err := p.NextPage(ctx)
+		if err != nil {
+			return allCollections, err
+		}
+
+		allCollections = append(allCollections, collections...)
+	}
+
+	return allCollections, nil
+}
+
+// PrimaryContract returns the first primary asset contract of the collection.
+// It returns false if the collection was returned without its contracts.
+func (c Collection) PrimaryContract() (AssetContract, bool) {
+	if len(c.PrimaryAssetContracts) == 0 {
+		return AssetContract{}, false
+	}
+	return c.PrimaryAssetContracts[0], true
+}
+
+// Contract returns the primary asset contract of the collection with the
+// given address.
+func (c Collection) Contract(address Address) (AssetContract, bool) {
+	for _, contract := range c.PrimaryAssetContracts {
+		if contract.Address.Equal(address) {
+			return contract, true
+		}
+	}
+	return AssetContract{}, false
+}
+
+// IsSafelisted reports whether the collection has been approved or verified
+// by OpenSea.
+func (c Collection) IsSafelisted() bool {
+	return c.SafelistRequestStatus == SafelistApproved || c.SafelistRequestStatus == SafelistVerified
+}
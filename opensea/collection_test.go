@@ -7,20 +7,18 @@ import (
 	"os"
 	"reflect"
 	"testing"
-	"time"
 
-	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 )
 
 func TestOpenSeaClient_GetCollection(t *testing.T) {
 	type fields struct {
-		Log          *zap.SugaredLogger
-		apiKey       string
-		client       *http.Client
-		baseURL      string
-		limitAssets  int
-		requestDelay time.Duration
+		Log         Logger
+		apiKey      string
+		client      *http.Client
+		baseURL     string
+		limitAssets int
+		Limiter     *RateLimiter
 	}
 	type args struct {
 		slug string
@@ -37,12 +35,12 @@ func TestOpenSeaClient_GetCollection(t *testing.T) {
 		{
 			name: "Get collection",
 			fields: fields{
-				Log:          zaptest.NewLogger(t).Sugar(),
-				apiKey:       "",
-				client:       &http.Client{},
-				baseURL:      "https://api.opensea.io",
-				limitAssets:  50,
-				requestDelay: time.Millisecond * 250,
+				Log:         zaptest.NewLogger(t).Sugar(),
+				apiKey:      "",
+				client:      &http.Client{},
+				baseURL:     "https://api.opensea.io",
+				limitAssets: 50,
+				Limiter:     NewRateLimiter(4, 1),
 			},
 			args: args{
 				slug: "boredapeyachtclub",
@@ -58,8 +56,8 @@ func TestOpenSeaClient_GetCollection(t *testing.T) {
 				if r.URL.Path != tt.path {
 					t.Errorf("Expected to request '%s', got: %s", tt.path, r.URL.Path)
 				}
-				if r.Header.Get("Content-Type") != "application/json" {
-					t.Errorf("Expected Content-Type: application/json header, got: %s", r.Header.Get("Accept"))
+				if r.Header.Get("Accept") != "application/json" {
+					t.Errorf("Expected Accept: application/json header, got: %s", r.Header.Get("Accept"))
 				}
 				w.WriteHeader(http.StatusOK)
 
@@ -80,12 +78,12 @@ func TestOpenSeaClient_GetCollection(t *testing.T) {
 			defer server.Close()
 
 			c := &OpenSeaClient{
-				Log:          tt.fields.Log,
-				apiKey:       tt.fields.apiKey,
-				client:       tt.fields.client,
-				baseURL:      server.URL,
-				limitAssets:  tt.fields.limitAssets,
-				requestDelay: tt.fields.requestDelay,
+				Log:         tt.fields.Log,
+				apiKey:      tt.fields.apiKey,
+				client:      tt.fields.client,
+				baseURL:     server.URL,
+				Limiter:     tt.fields.Limiter,
+				limitAssets: tt.fields.limitAssets,
 			}
 			got, err := c.GetCollection(tt.args.slug)
 			if (err != nil) != tt.wantErr {
@@ -98,3 +96,164 @@ func TestOpenSeaClient_GetCollection(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenSeaClient_GetCollectionStats(t *testing.T) {
+	type fields struct {
+		Log         Logger
+		apiKey      string
+		client      *http.Client
+		baseURL     string
+		limitAssets int
+		Limiter     *RateLimiter
+	}
+	type args struct {
+		slug string
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		path        string
+		fixturePath string
+		want        CollectionStats
+		wantErr     bool
+	}{
+		{
+			name: "Get collection stats",
+			fields: fields{
+				Log:         zaptest.NewLogger(t).Sugar(),
+				apiKey:      "",
+				client:      &http.Client{},
+				baseURL:     "https://api.opensea.io",
+				limitAssets: 50,
+				Limiter:     NewRateLimiter(4, 1),
+			},
+			args: args{
+				slug: "boredapeyachtclub",
+			},
+			path:        "/api/v1/collection/boredapeyachtclub/stats",
+			fixturePath: "../testdata/get_collection_stats.json",
+			want:        FixtureGetCollectionResp.Stats,
+		},
+		{
+			name: "Escape slug",
+			fields: fields{
+				Log:         zaptest.NewLogger(t).Sugar(),
+				client:      &http.Client{},
+				limitAssets: 50,
+			},
+			args: args{
+				slug: "bored/ape",
+			},
+			path:        "/api/v1/collection/bored%2Fape/stats",
+			fixturePath: "../testdata/get_collection_stats.json",
+			want:        FixtureGetCollectionResp.Stats,
+		},
+		{
+			name: "Empty slug",
+			fields: fields{
+				Log:         zaptest.NewLogger(t).Sugar(),
+				client:      &http.Client{},
+				limitAssets: 50,
+			},
+			args: args{
+				slug: "",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.EscapedPath() != tt.path {
+					t.Errorf("Expected to request '%s', got: %s", tt.path, r.URL.EscapedPath())
+				}
+				if r.Header.Get("Accept") != "application/json" {
+					t.Errorf("Expected Accept: application/json header, got: %s", r.Header.Get("Accept"))
+				}
+				w.WriteHeader(http.StatusOK)
+
+				// Read the fixture
+				jsonFile, err := os.Open(tt.fixturePath)
+				if err != nil {
+					t.Errorf("Failed to open fixture file: %s", err)
+				}
+				defer jsonFile.Close()
+
+				// Write the fixture to the response
+				jsonFile.Seek(0, 0)
+				_, err = io.Copy(w, jsonFile)
+				if err != nil {
+					t.Errorf("Failed to write fixture to response: %s", err)
+				}
+			}))
+			defer server.Close()
+
+			c := &OpenSeaClient{
+				Log:         tt.fields.Log,
+				apiKey:      tt.fields.apiKey,
+				client:      tt.fields.client,
+				baseURL:     server.URL,
+				Limiter:     tt.fields.Limiter,
+				limitAssets: tt.fields.limitAssets,
+			}
+			got, err := c.GetCollectionStats(tt.args.slug)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OpenSeaClient.GetCollectionStats() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OpenSeaClient.GetCollectionStats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSeaClient_GetCollections(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/collections" {
+			t.Errorf("Expected to request '/api/v1/collections', got: %s", r.URL.Path)
+		}
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("offset") != "0" {
+			w.Write([]byte(`[]`))
+			return
+		}
+
+		// Read the fixture
+		jsonFile, err := os.Open("../testdata/get_collections.json")
+		if err != nil {
+			t.Errorf("Failed to open fixture file: %s", err)
+		}
+		defer jsonFile.Close()
+
+		// Write the fixture to the response
+		_, err = io.Copy(w, jsonFile)
+		if err != nil {
+			t.Errorf("Failed to write fixture to response: %s", err)
+		}
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 2,
+	}
+	got, err := c.GetCollections("0x3b417FaeE9d2ff636701100891DC2755b5321Cc3")
+	if err != nil {
+		t.Fatalf("OpenSeaClient.GetCollections() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, FixtureGetCollectionsResp) {
+		t.Errorf("OpenSeaClient.GetCollections() = %v, want %v", got, FixtureGetCollectionsResp)
+	}
+	want := []string{
+		"asset_owner=0x3b417faee9d2ff636701100891dc2755b5321cc3&limit=2&offset=0",
+		"asset_owner=0x3b417faee9d2ff636701100891dc2755b5321cc3&limit=2&offset=2",
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("requested %q, want %q", queries, want)
+	}
+}
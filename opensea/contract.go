@@ -0,0 +1,82 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// AssetContract represents an asset contract on OpenSea.
+// https://docs.opensea.io/reference/contract-object
+type AssetContract struct {
+	Address                     Address     `json:"address"`
+	AssetContractType           string      `json:"asset_contract_type"`
+	BuyerFeeBasisPoints         BasisPoints `json:"buyer_fee_basis_points"`
+	Collection                  *Collection `json:"collection"`
+	CreatedDate                 Timestamp   `json:"created_date"`
+	DefaultToFiat               bool        `json:"default_to_fiat"`
+	Description                 string      `json:"description"`
+	DevBuyerFeeBasisPoints      BasisPoints `json:"dev_buyer_fee_basis_points"`
+	DevSellerFeeBasisPoints     BasisPoints `json:"dev_seller_fee_basis_points"`
+	ExternalLink                string      `json:"external_link"`
+	ImageURL                    string      `json:"image_url"`
+	Name                        string      `json:"name"`
+	NftVersion                  string      `json:"nft_version"`
+	OnlyProxiedTransfers        bool        `json:"only_proxied_transfers"`
+	OpenseaBuyerFeeBasisPoints  BasisPoints `json:"opensea_buyer_fee_basis_points"`
+	OpenseaSellerFeeBasisPoints BasisPoints `json:"opensea_seller_fee_basis_points"`
+	OpenseaVersion              string      `json:"opensea_version"`
+	Owner                       int         `json:"owner"`
+	PayoutAddress               Address     `json:"payout_address"`
+	SchemaName                  string      `json:"schema_name"`
+	SellerFeeBasisPoints        BasisPoints `json:"seller_fee_basis_points"`
+	Symbol                      string      `json:"symbol"`
+	TotalSupply                 string      `json:"total_supply"`
+}
+
+// CollectionSlug returns the slug of the collection of the contract, or an
+// empty string if the contract was not returned with its collection.
+func (a AssetContract) CollectionSlug() string {
+	if a.Collection == nil {
+		return ""
+	}
+	return a.Collection.Slug
+}
+
+// GetAssetContract returns an asset contract by address, including the
+// collection it belongs to.
+// https://docs.opensea.io/reference/retrieving-a-single-contract
+func (c *OpenSeaClient) GetAssetContract(address Address) (AssetContract, error) {
+	return c.GetAssetContractContext(context.Background(), address)
+}
+
+// GetAssetContractContext is like GetAssetContract but bound to ctx.
+func (c *OpenSeaClient) GetAssetContractContext(ctx context.Context, address Address) (AssetContract, error) {
+	var contract AssetContract
+	if err := validateAddressParam("address", address); err != nil {
+		c.logf(EventError, "Error validating address: %s", err)
+		return contract, err
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/api/v1/asset_contract/%s", c.baseURL, url.PathEscape(address.String())))
+	if err != nil {
+		c.logf(EventError, "Error parsing url: %s", err)
+		return contract, err
+	}
+
+	resp, err := c.GetContext(ctx, u)
+	if err != nil {
+		c.logf(EventError, "Error getting asset contract: %s", err)
+		return contract, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&contract)
+	if err != nil {
+		c.logf(EventError, "Error decoding response: %s", err)
+		return contract, err
+	}
+
+	return contract, nil
+}
@@ -0,0 +1,103 @@
+package opensea
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOpenSeaClient_GetAssetContract(t *testing.T) {
+	type fields struct {
+		Log         Logger
+		apiKey      string
+		client      *http.Client
+		baseURL     string
+		limitAssets int
+		Limiter     *RateLimiter
+	}
+	type args struct {
+		address Address
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		path        string
+		fixturePath string
+		want        AssetContract
+		wantSlug    string
+		wantErr     bool
+	}{
+		{
+			name: "Get asset contract",
+			fields: fields{
+				Log:         zaptest.NewLogger(t).Sugar(),
+				apiKey:      "",
+				client:      &http.Client{},
+				baseURL:     "https://api.opensea.io",
+				limitAssets: 50,
+				Limiter:     NewRateLimiter(4, 1),
+			},
+			args: args{
+				address: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+			},
+			path:        "/api/v1/asset_contract/0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+			fixturePath: "../testdata/get_asset_contract.json",
+			want:        FixtureGetAssetContractResp,
+			wantSlug:    "boredapeyachtclub",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("Expected to request '%s', got: %s", tt.path, r.URL.Path)
+				}
+				if r.Header.Get("Accept") != "application/json" {
+					t.Errorf("Expected Accept: application/json header, got: %s", r.Header.Get("Accept"))
+				}
+				w.WriteHeader(http.StatusOK)
+
+				// Read the fixture
+				jsonFile, err := os.Open(tt.fixturePath)
+				if err != nil {
+					t.Errorf("Failed to open fixture file: %s", err)
+				}
+				defer jsonFile.Close()
+
+				// Write the fixture to the response
+				jsonFile.Seek(0, 0)
+				_, err = io.Copy(w, jsonFile)
+				if err != nil {
+					t.Errorf("Failed to write fixture to response: %s", err)
+				}
+			}))
+			defer server.Close()
+
+			c := &OpenSeaClient{
+				Log:         tt.fields.Log,
+				apiKey:      tt.fields.apiKey,
+				client:      tt.fields.client,
+				baseURL:     server.URL,
+				Limiter:     tt.fields.Limiter,
+				limitAssets: tt.fields.limitAssets,
+			}
+			got, err := c.GetAssetContract(tt.args.address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OpenSeaClient.GetAssetContract() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OpenSeaClient.GetAssetContract() = %v, want %v", got, tt.want)
+			}
+			if got.CollectionSlug() != tt.wantSlug {
+				t.Errorf("AssetContract.CollectionSlug() = %s, want %s", got.CollectionSlug(), tt.wantSlug)
+			}
+		})
+	}
+}
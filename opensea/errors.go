@@ -0,0 +1,113 @@
+package opensea
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxErrorBodySize is the number of bytes of an error response kept in APIError.
+const maxErrorBodySize = 1024
+
+// APIError is returned when the OpenSea API responds with a non-2xx status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// URL is the requested URL.
+	URL string
+	// Body is the beginning of the response body, truncated to maxErrorBodySize.
+	Body string
+	// Detail is the error message parsed from the response body, if any.
+	Detail string
+	// RetryAfter is the delay requested by the server's Retry-After header.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	msg := e.Detail
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("opensea: %s: %d %s", e.URL, e.StatusCode, msg)
+}
+
+// ValidationError is returned when request parameters are rejected before
+// a request is sent.
+type ValidationError struct {
+	// Field is the name of the offending query parameter.
+	Field string
+	// Message describes the problem.
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("opensea: invalid %s: %s", e.Field, e.Message)
+}
+
+// errorResponse covers the error shapes returned by the OpenSea API.
+type errorResponse struct {
+	Detail  string   `json:"detail"`
+	Message string   `json:"message"`
+	Errors  []string `json:"errors"`
+}
+
+// newAPIError builds an APIError from a non-2xx response and closes its body.
+func newAPIError(resp *http.Response) *APIError {
+	defer resp.Body.Close()
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		URL:        resp.Request.URL.String(),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	apiErr.Body = string(body)
+
+	var errResp errorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil {
+		switch {
+		case errResp.Detail != "":
+			apiErr.Detail = errResp.Detail
+		case errResp.Message != "":
+			apiErr.Detail = errResp.Message
+		case len(errResp.Errors) > 0:
+			apiErr.Detail = strings.Join(errResp.Errors, "; ")
+		}
+	}
+
+	return apiErr
+}
+
+// hasStatus reports whether err is an APIError with one of the given status codes.
+func hasStatus(err error, codes ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, code := range codes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an APIError for a 404 response.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsRateLimited reports whether err is an APIError for a 429 response.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized reports whether err is an APIError for a 401 or 403 response.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized, http.StatusForbidden)
+}
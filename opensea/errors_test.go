@@ -0,0 +1,91 @@
+package opensea
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOpenSeaClient_APIError(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantDetail   string
+		notFound     bool
+		rateLimited  bool
+		unauthorized bool
+	}{
+		{
+			name:       "Not found",
+			status:     http.StatusNotFound,
+			body:       `{"detail": "Not found."}`,
+			wantDetail: "Not found.",
+			notFound:   true,
+		},
+		{
+			name:        "Rate limited",
+			status:      http.StatusTooManyRequests,
+			body:        `{"detail": "Request was throttled. Expected available in 1 second."}`,
+			wantDetail:  "Request was throttled. Expected available in 1 second.",
+			rateLimited: true,
+		},
+		{
+			name:         "Unauthorized",
+			status:       http.StatusUnauthorized,
+			body:         `{"success": false, "errors": ["Invalid API key"]}`,
+			wantDetail:   "Invalid API key",
+			unauthorized: true,
+		},
+		{
+			name:   "Server error without JSON body",
+			status: http.StatusBadGateway,
+			body:   "<html>Bad Gateway</html>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c := &OpenSeaClient{
+				Log:     zaptest.NewLogger(t).Sugar(),
+				client:  &http.Client{},
+				baseURL: server.URL,
+			}
+			_, err := c.GetCollection("boredapeyachtclub")
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("OpenSeaClient.GetCollection() error = %v, want *APIError", err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("APIError.StatusCode = %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			if apiErr.URL != server.URL+"/api/v1/collection/boredapeyachtclub" {
+				t.Errorf("APIError.URL = %s", apiErr.URL)
+			}
+			if apiErr.Body != tt.body {
+				t.Errorf("APIError.Body = %q, want %q", apiErr.Body, tt.body)
+			}
+			if apiErr.Detail != tt.wantDetail {
+				t.Errorf("APIError.Detail = %q, want %q", apiErr.Detail, tt.wantDetail)
+			}
+			if IsNotFound(err) != tt.notFound {
+				t.Errorf("IsNotFound() = %v, want %v", IsNotFound(err), tt.notFound)
+			}
+			if IsRateLimited(err) != tt.rateLimited {
+				t.Errorf("IsRateLimited() = %v, want %v", IsRateLimited(err), tt.rateLimited)
+			}
+			if IsUnauthorized(err) != tt.unauthorized {
+				t.Errorf("IsUnauthorized() = %v, want %v", IsUnauthorized(err), tt.unauthorized)
+			}
+		})
+	}
+}
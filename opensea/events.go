@@ -0,0 +1,395 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// EventType is the kind of an asset event.
+type EventType string
+
+// Values of Event.EventType and EventsQuery.EventType.
+const (
+	EventTypeListing         EventType = "created"
+	EventTypeSale            EventType = "successful"
+	EventTypeCancellation    EventType = "cancelled"
+	EventTypeBid             EventType = "bid_entered"
+	EventTypeBidWithdrawal   EventType = "bid_withdrawn"
+	EventTypeOffer           EventType = "offer_entered"
+	EventTypeTransfer        EventType = "transfer"
+	EventTypeApproval        EventType = "approve"
+	EventTypeCollectionOffer EventType = "collection_offer"
+)
+
+// Event is an asset event. Exactly one of the payload fields matching
+// EventType is set; the others are nil.
+// https://docs.opensea.io/reference/retrieving-asset-events
+type Event struct {
+	ID              int64        `json:"id"`
+	Asset           *Asset       `json:"asset"`
+	CollectionSlug  string       `json:"collection_slug"`
+	ContractAddress Address      `json:"contract_address"`
+	CreatedDate     Timestamp    `json:"created_date"`
+	EventTimestamp  Timestamp    `json:"event_timestamp"`
+	EventType       EventType    `json:"event_type"`
+	Quantity        string       `json:"quantity"`
+	Transaction     *Transaction `json:"transaction"`
+
+	Sale         *SaleEvent         `json:"-"`
+	Listing      *ListingEvent      `json:"-"`
+	Cancellation *CancellationEvent `json:"-"`
+	Bid          *BidEvent          `json:"-"`
+	Transfer     *TransferEvent     `json:"-"`
+	Approval     *ApprovalEvent     `json:"-"`
+}
+
+// SaleEvent is the payload of an EventTypeSale event.
+type SaleEvent struct {
+	AuctionType  string        `json:"auction_type"`
+	IsPrivate    bool          `json:"is_private"`
+	PaymentToken *PaymentToken `json:"payment_token"`
+	Seller       *Account      `json:"seller"`
+	TotalPrice   Price         `json:"total_price"`
+	Winner       *Account      `json:"winner_account"`
+}
+
+// ListingEvent is the payload of an EventTypeListing event.
+type ListingEvent struct {
+	AuctionType   string        `json:"auction_type"`
+	Duration      string        `json:"duration"`
+	EndingPrice   Price         `json:"ending_price"`
+	IsPrivate     bool          `json:"is_private"`
+	PaymentToken  *PaymentToken `json:"payment_token"`
+	Seller        *Account      `json:"seller"`
+	StartingPrice Price         `json:"starting_price"`
+}
+
+// CancellationEvent is the payload of an EventTypeCancellation event.
+type CancellationEvent struct {
+	PaymentToken *PaymentToken `json:"payment_token"`
+	Seller       *Account      `json:"seller"`
+	TotalPrice   Price         `json:"total_price"`
+}
+
+// BidEvent is the payload of the EventTypeBid, EventTypeBidWithdrawal,
+// EventTypeOffer and EventTypeCollectionOffer events.
+type BidEvent struct {
+	BidAmount    Price         `json:"bid_amount"`
+	From         *Account      `json:"from_account"`
+	PaymentToken *PaymentToken `json:"payment_token"`
+}
+
+// TransferEvent is the payload of an EventTypeTransfer event.
+type TransferEvent struct {
+	From *Account `json:"from_account"`
+	To   *Account `json:"to_account"`
+}
+
+// ApprovalEvent is the payload of an EventTypeApproval event.
+type ApprovalEvent struct {
+	Approved *Account `json:"approved_account"`
+	Owner    *Account `json:"owner_account"`
+}
+
+// Transaction is the on-chain transaction of an event.
+type Transaction struct {
+	BlockHash        string    `json:"block_hash"`
+	BlockNumber      string    `json:"block_number"`
+	From             *Account  `json:"from_account"`
+	ID               int64     `json:"id"`
+	Timestamp        Timestamp `json:"timestamp"`
+	To               *Account  `json:"to_account"`
+	TransactionHash  string    `json:"transaction_hash"`
+	TransactionIndex string    `json:"transaction_index"`
+}
+
+// event has the fields of Event without its methods.
+type event Event
+
+// payload returns a pointer to the payload field of e for its event type,
+// allocating it if allocate is set.
+func (e *Event) payload(allocate bool) interface{} {
+	switch e.EventType {
+	case EventTypeSale:
+		if allocate {
+			e.Sale = new(SaleEvent)
+		}
+		if e.Sale != nil {
+			return e.Sale
+		}
+	case EventTypeListing:
+		if allocate {
+			e.Listing = new(ListingEvent)
+		}
+		if e.Listing != nil {
+			return e.Listing
+		}
+	case EventTypeCancellation:
+		if allocate {
+			e.Cancellation = new(CancellationEvent)
+		}
+		if e.Cancellation != nil {
+			return e.Cancellation
+		}
+	case EventTypeBid, EventTypeBidWithdrawal, EventTypeOffer, EventTypeCollectionOffer:
+		if allocate {
+			e.Bid = new(BidEvent)
+		}
+		if e.Bid != nil {
+			return e.Bid
+		}
+	case EventTypeTransfer:
+		if allocate {
+			e.Transfer = new(TransferEvent)
+		}
+		if e.Transfer != nil {
+			return e.Transfer
+		}
+	case EventTypeApproval:
+		if allocate {
+			e.Approval = new(ApprovalEvent)
+		}
+		if e.Approval != nil {
+			return e.Approval
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It decodes the payload
+// matching the event type; unknown event types only get the common fields.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var ev event
+	if err := json.Unmarshal(data, &ev); err != nil {
+		return err
+	}
+	*e = Event(ev)
+	p := e.payload(true)
+	if p == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, p); err != nil {
+		return err
+	}
+
+	// Prices take the decimals of the payment token of the event.
+	switch p := p.(type) {
+	case *SaleEvent:
+		p.TotalPrice.withDecimals(p.PaymentToken)
+	case *ListingEvent:
+		p.StartingPrice.withDecimals(p.PaymentToken)
+		p.EndingPrice.withDecimals(p.PaymentToken)
+	case *CancellationEvent:
+		p.TotalPrice.withDecimals(p.PaymentToken)
+	case *BidEvent:
+		p.BidAmount.withDecimals(p.PaymentToken)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The payload fields are flattened
+// into the event object the way the API returns them.
+func (e Event) MarshalJSON() ([]byte, error) {
+	common, err := json.Marshal(event(e))
+	if err != nil {
+		return nil, err
+	}
+	p := e.payload(false)
+	if p == nil {
+		return common, nil
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(common, &fields); err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// TransactionHash returns the hash of the transaction of the event, or an
+// empty string for off-chain events such as listings and bids.
+func (e Event) TransactionHash() string {
+	if e.Transaction == nil {
+		return ""
+	}
+	return e.Transaction.TransactionHash
+}
+
+type GetEventsResponse struct {
+	AssetEvents []Event `json:"asset_events"`
+	Next        string  `json:"next"`
+	Previous    string  `json:"previous"`
+
+	// Filtered counts the events dropped by the filters of the client.
+	Filtered FilterCounts `json:"-"`
+}
+
+// EventsQuery holds the filters for retrieving events. Empty fields are not
+// sent to the API.
+// https://docs.opensea.io/reference/retrieving-asset-events
+type EventsQuery struct {
+	AssetContractAddress Address
+	// TokenID requires AssetContractAddress to be set.
+	TokenID        TokenID
+	CollectionSlug string
+	// AccountAddress matches events in which the account took part.
+	AccountAddress Address
+	EventType      EventType
+	OnlyOpenSea    bool
+	OccurredBefore time.Time
+	OccurredAfter  time.Time
+	// Limit is the page size. Zero uses the page size of the client.
+	Limit int
+	// Cursor is the position of the page to request, taken from the Next or
+	// Previous field of a previous response.
+	Cursor string
+}
+
+// Validate checks the query for invalid values and unsupported combinations.
+func (q EventsQuery) Validate() error {
+	if q.AssetContractAddress != "" {
+		if err := validateAddressParam("asset_contract_address", q.AssetContractAddress); err != nil {
+			return err
+		}
+	}
+	if q.AccountAddress != "" {
+		if err := validateAddressParam("account_address", q.AccountAddress); err != nil {
+			return err
+		}
+	}
+	if q.TokenID.IsSet() && q.AssetContractAddress == "" {
+		return &ValidationError{Field: "token_id", Message: "requires asset_contract_address"}
+	}
+	switch q.EventType {
+	case "", EventTypeListing, EventTypeSale, EventTypeCancellation, EventTypeBid, EventTypeBidWithdrawal,
+		EventTypeOffer, EventTypeTransfer, EventTypeApproval, EventTypeCollectionOffer:
+	default:
+		return &ValidationError{Field: "event_type", Message: fmt.Sprintf("unsupported value %q", q.EventType)}
+	}
+	if !q.OccurredBefore.IsZero() && !q.OccurredAfter.IsZero() && !q.OccurredAfter.Before(q.OccurredBefore) {
+		return &ValidationError{Field: "occurred_after", Message: "must be before occurred_before"}
+	}
+	if q.Limit < 0 || q.Limit > maxPageSize {
+		return &ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d, or 0 for the page size of the client", maxPageSize)}
+	}
+	return nil
+}
+
+// values encodes the query, using limit when q.Limit is not set.
+func (q EventsQuery) values(limit int) url.Values {
+	v := url.Values{}
+	if q.AssetContractAddress != "" {
+		v.Set("asset_contract_address", q.AssetContractAddress.String())
+	}
+	if q.TokenID.IsSet() {
+		v.Set("token_id", q.TokenID.String())
+	}
+	if q.CollectionSlug != "" {
+		v.Set("collection_slug", q.CollectionSlug)
+	}
+	if q.AccountAddress != "" {
+		v.Set("account_address", q.AccountAddress.String())
+	}
+	if q.EventType != "" {
+		v.Set("event_type", string(q.EventType))
+	}
+	if q.OnlyOpenSea {
+		v.Set("only_opensea", "true")
+	}
+	if !q.OccurredBefore.IsZero() {
+		v.Set("occurred_before", fmt.Sprint(q.OccurredBefore.Unix()))
+	}
+	if !q.OccurredAfter.IsZero() {
+		v.Set("occurred_after", fmt.Sprint(q.OccurredAfter.Unix()))
+	}
+	if q.Limit > 0 {
+		limit = q.Limit
+	}
+	v.Set("limit", fmt.Sprint(limit))
+	if q.Cursor != "" {
+		v.Set("cursor", q.Cursor)
+	}
+	return v
+}
+
+// GetEvents gets a single page of events matching the query, newest first.
+// https://docs.opensea.io/reference/retrieving-asset-events
+func (c *OpenSeaClient) GetEvents(query EventsQuery) (GetEventsResponse, error) {
+	return c.GetEventsContext(context.Background(), query)
+}
+
+// GetEventsContext is like GetEvents but bound to ctx.
+func (c *OpenSeaClient) GetEventsContext(ctx context.Context, query EventsQuery) (GetEventsResponse, error) {
+	var osResp GetEventsResponse
+	if err := query.Validate(); err != nil {
+		c.logf(EventError, "Error validating query: %s", err)
+		return osResp, err
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/api/v1/events", c.baseURL))
+	if err != nil {
+		c.logf(EventError, "Error parsing url: %s", err)
+		return osResp, err
+	}
+
+	// Set query params
+	u.RawQuery = query.values(c.limitAssets).Encode()
+
+	resp, err := c.GetContext(ctx, u)
+	if err != nil {
+		c.logf(EventError, "Error getting events: %s", err)
+		return osResp, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&osResp)
+	if err != nil {
+		c.logf(EventError, "Error decoding response: %s", err)
+		return osResp, err
+	}
+
+	osResp.AssetEvents, osResp.Filtered = c.filterEvents(osResp.AssetEvents)
+
+	return osResp, nil
+}
+
+// GetAllEvents returns all events matching the query, following the pages
+// from query.Cursor on. The number of events dropped by the filters of the
+// client is reported by EventsPaginator.Filtered.
+func (c *OpenSeaClient) GetAllEvents(query EventsQuery) ([]Event, error) {
+	return c.GetAllEventsContext(context.Background(), query)
+}
+
+// GetAllEventsContext is like GetAllEvents but bound to ctx.
+func (c *OpenSeaClient) GetAllEventsContext(ctx context.Context, query EventsQuery) ([]Event, error) {
+	events, _, err := c.getAllEvents(ctx, query)
+	return events, err
+}
+
+// getAllEvents is like GetAllEventsContext but also returns the number of
+// events dropped by the filters of the client.
+func (c *OpenSeaClient) getAllEvents(ctx context.Context, query EventsQuery) ([]Event, FilterCounts, error) {
+	var allEvents []Event
+
+	p := c.NewEventsPaginator(query)
+	for p.HasNextPage() {
+		resp, err := p.NextPage(ctx)
+		if err != nil {
+			return allEvents, p.Filtered(), err
+		}
+
+		allEvents = append(allEvents, resp.AssetEvents...)
+	}
+
+	return allEvents, p.Filtered(), nil
+}
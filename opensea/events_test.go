@@ -0,0 +1,259 @@
+package opensea
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOpenSeaClient_GetEvents(t *testing.T) {
+	type fields struct {
+		Log         Logger
+		apiKey      string
+		client      *http.Client
+		baseURL     string
+		limitAssets int
+		Limiter     *RateLimiter
+	}
+	type args struct {
+		query EventsQuery
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		path        string
+		rawQuery    string
+		fixturePath string
+		want        GetEventsResponse
+		wantErr     bool
+	}{
+		{
+			name: "Get events of a collection",
+			fields: fields{
+				Log:         zaptest.NewLogger(t).Sugar(),
+				apiKey:      "",
+				client:      &http.Client{},
+				baseURL:     "https://api.opensea.io",
+				limitAssets: 50,
+				Limiter:     NewRateLimiter(4, 1),
+			},
+			args: args{
+				query: EventsQuery{CollectionSlug: "boredapeyachtclub"},
+			},
+			path:        "/api/v1/events",
+			rawQuery:    "collection_slug=boredapeyachtclub&limit=50",
+			fixturePath: "../testdata/get_events.json",
+			want:        FixtureGetEventsResp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("Expected to request '%s', got: %s", tt.path, r.URL.Path)
+				}
+				if r.URL.RawQuery != tt.rawQuery {
+					t.Errorf("Expected query '%s', got: %s", tt.rawQuery, r.URL.RawQuery)
+				}
+				if r.Header.Get("Accept") != "application/json" {
+					t.Errorf("Expected Accept: application/json header, got: %s", r.Header.Get("Accept"))
+				}
+				w.WriteHeader(http.StatusOK)
+
+				// Read the fixture
+				jsonFile, err := os.Open(tt.fixturePath)
+				if err != nil {
+					t.Errorf("Failed to open fixture file: %s", err)
+				}
+				defer jsonFile.Close()
+
+				// Write the fixture to the response
+				_, err = io.Copy(w, jsonFile)
+				if err != nil {
+					t.Errorf("Failed to write fixture to response: %s", err)
+				}
+			}))
+			defer server.Close()
+
+			c := &OpenSeaClient{
+				Log:         tt.fields.Log,
+				apiKey:      tt.fields.apiKey,
+				client:      tt.fields.client,
+				baseURL:     server.URL,
+				Limiter:     tt.fields.Limiter,
+				limitAssets: tt.fields.limitAssets,
+			}
+			got, err := c.GetEvents(tt.args.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OpenSeaClient.GetEvents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OpenSeaClient.GetEvents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvent_MarshalJSON(t *testing.T) {
+	for _, want := range FixtureGetEventsResp.AssetEvents {
+		data, err := json.Marshal(want)
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		var got Event
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("json.Unmarshal() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("round trip of %s event = %+v, want %+v", want.EventType, got, want)
+		}
+	}
+}
+
+func TestEvent_UnmarshalJSON_UnknownType(t *testing.T) {
+	var got Event
+	if err := json.Unmarshal([]byte(`{"id": 1, "event_type": "custom", "total_price": "1"}`), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	want := Event{ID: 1, EventType: "custom"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", got, want)
+	}
+}
+
+func TestEventsQuery_Values(t *testing.T) {
+	tests := []struct {
+		name  string
+		query EventsQuery
+		want  string
+	}{
+		{
+			name: "Sales of a token",
+			query: EventsQuery{
+				AssetContractAddress: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+				TokenID:              MustParseTokenID("7090"),
+				EventType:            EventTypeSale,
+				OnlyOpenSea:          true,
+			},
+			want: "asset_contract_address=0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d&event_type=successful&limit=50&only_opensea=true&token_id=7090",
+		},
+		{
+			name: "Account in a time range",
+			query: EventsQuery{
+				AccountAddress: "0x8d4ec4e62d3c6e2e6cbaa0ed3fd6b7bc6eae29d2",
+				OccurredAfter:  time.Date(2022, 3, 20, 0, 0, 0, 0, time.UTC),
+				OccurredBefore: time.Date(2022, 3, 21, 0, 0, 0, 0, time.UTC),
+				Limit:          20,
+				Cursor:         "LWV2ZW50X3RpbWVzdGFtcD0yMDIyLTAz",
+			},
+			want: "account_address=0x8d4ec4e62d3c6e2e6cbaa0ed3fd6b7bc6eae29d2&cursor=LWV2ZW50X3RpbWVzdGFtcD0yMDIyLTAz&limit=20&occurred_after=1647734400&occurred_before=1647820800",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.query.Validate(); err != nil {
+				t.Fatalf("EventsQuery.Validate() error = %v", err)
+			}
+			if got := tt.query.values(50).Encode(); got != tt.want {
+				t.Errorf("EventsQuery.values() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventsQuery_Validate(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		query     EventsQuery
+		wantField string
+	}{
+		{
+			name:      "Token ID without contract",
+			query:     EventsQuery{TokenID: TokenIDFromUint64(1)},
+			wantField: "token_id",
+		},
+		{
+			name:      "Unknown event type",
+			query:     EventsQuery{EventType: "sale"},
+			wantField: "event_type",
+		},
+		{
+			name:      "Empty time range",
+			query:     EventsQuery{OccurredAfter: now, OccurredBefore: now},
+			wantField: "occurred_after",
+		},
+		{
+			name:      "Limit too large",
+			query:     EventsQuery{Limit: 100},
+			wantField: "limit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("EventsQuery.Validate() error = %v, want *ValidationError", err)
+			}
+			if validationErr.Field != tt.wantField {
+				t.Errorf("ValidationError.Field = %s, want %s", validationErr.Field, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestOpenSeaClient_GetAllEvents(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"asset_events": [{"id": 3, "event_type": "transfer"}, {"id": 2, "event_type": "transfer"}], "next": "page2", "previous": null}`))
+		case "page2":
+			w.Write([]byte(`{"asset_events": [{"id": 1, "event_type": "transfer"}], "next": null, "previous": "page1"}`))
+		default:
+			t.Errorf("Unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 2,
+		pagination:  PaginationOffset,
+	}
+	got, err := c.GetAllEvents(EventsQuery{CollectionSlug: "boredapeyachtclub", EventType: EventTypeTransfer})
+	if err != nil {
+		t.Fatalf("OpenSeaClient.GetAllEvents() error = %v", err)
+	}
+	var ids []int64
+	for _, event := range got {
+		ids = append(ids, event.ID)
+		if event.Transfer == nil {
+			t.Errorf("Event.Transfer of event %d is nil", event.ID)
+		}
+	}
+	if want := []int64{3, 2, 1}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("OpenSeaClient.GetAllEvents() returned %v, want %v", ids, want)
+	}
+	want := []string{
+		"collection_slug=boredapeyachtclub&event_type=transfer&limit=2",
+		"collection_slug=boredapeyachtclub&cursor=page2&event_type=transfer&limit=2",
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("requested %q, want %q", queries, want)
+	}
+}
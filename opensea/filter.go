@@ -0,0 +1,161 @@
+package opensea
+
+// Filter drops assets from the responses of the client. Orders and events
+// are dropped with their asset; those without an asset are always kept.
+// Filters are set with WithFilters and apply to GetAssets, SearchAssets,
+// GetCheapestOrders, GetEvents and the functions built on them. Single
+// pages report the number of dropped items in their Filtered field, the
+// paginators and AssetIterator across pages.
+type Filter struct {
+	// Name identifies the filter in FilterCounts.
+	Name string
+	// Drop reports whether the asset should be dropped.
+	Drop func(Asset) bool
+}
+
+// Names of the built-in filters.
+const (
+	FilterHiddenCollections = "hidden_collection"
+	FilterNSFW              = "nsfw"
+	FilterNotSafelisted     = "not_safelisted"
+	FilterPresale           = "presale"
+)
+
+// NewFilter returns a filter dropping the assets for which drop returns true.
+func NewFilter(name string, drop func(Asset) bool) Filter {
+	return Filter{Name: name, Drop: drop}
+}
+
+// HideHiddenCollections drops assets of collections hidden on OpenSea.
+func HideHiddenCollections() Filter {
+	return NewFilter(FilterHiddenCollections, func(a Asset) bool {
+		return a.Collection.Hidden
+	})
+}
+
+// HideNSFW drops assets flagged as not safe for work.
+func HideNSFW() Filter {
+	return NewFilter(FilterNSFW, func(a Asset) bool {
+		return a.IsNsfw
+	})
+}
+
+// HideNotSafelisted drops assets of collections that were not approved or
+// verified by OpenSea, see Collection.IsSafelisted. Assets whose collection
+// has no safelist status, as in some nested responses, are kept.
+func HideNotSafelisted() Filter {
+	return NewFilter(FilterNotSafelisted, func(a Asset) bool {
+		return a.Collection.SafelistRequestStatus != "" && !a.Collection.IsSafelisted()
+	})
+}
+
+// HidePresale drops assets that have not been minted yet.
+func HidePresale() Filter {
+	return NewFilter(FilterPresale, func(a Asset) bool {
+		return a.IsPresale
+	})
+}
+
+// FilterCounts is the number of items dropped by each filter, by filter
+// name. An item is counted once, by the first filter dropping it.
+type FilterCounts map[string]int
+
+// Total returns the number of items dropped by all filters.
+func (f FilterCounts) Total() int {
+	total := 0
+	for _, n := range f {
+		total += n
+	}
+	return total
+}
+
+// merge adds the counts of g to f, allocating f if needed.
+func (f FilterCounts) merge(g FilterCounts) FilterCounts {
+	for name, n := range g {
+		if f == nil {
+			f = make(FilterCounts)
+		}
+		f[name] += n
+	}
+	return f
+}
+
+// clone returns a copy of f.
+func (f FilterCounts) clone() FilterCounts {
+	return FilterCounts(nil).merge(f)
+}
+
+// drop returns the name of the first filter dropping a, or false if a is
+// kept.
+func (c *OpenSeaClient) drop(a *Asset) (string, bool) {
+	if a == nil {
+		return "", false
+	}
+	for _, f := range c.filters {
+		if f.Drop(*a) {
+			return f.Name, true
+		}
+	}
+	return "", false
+}
+
+// filterAssets returns the assets kept by the filters of the client and the
+// counts of those dropped.
+func (c *OpenSeaClient) filterAssets(assets []Asset) ([]Asset, FilterCounts) {
+	if len(c.filters) == 0 {
+		return assets, nil
+	}
+	var counts FilterCounts
+	kept := assets[:0]
+	for i := range assets {
+		if name, ok := c.drop(&assets[i]); ok {
+			counts = counts.add(name)
+			continue
+		}
+		kept = append(kept, assets[i])
+	}
+	return kept, counts
+}
+
+// filterOrders is like filterAssets for the assets of orders.
+func (c *OpenSeaClient) filterOrders(orders []Order) ([]Order, FilterCounts) {
+	if len(c.filters) == 0 {
+		return orders, nil
+	}
+	var counts FilterCounts
+	kept := orders[:0]
+	for _, o := range orders {
+		if name, ok := c.drop(o.Asset); ok {
+			counts = counts.add(name)
+			continue
+		}
+		kept = append(kept, o)
+	}
+	return kept, counts
+}
+
+// filterEvents is like filterAssets for the assets of events.
+func (c *OpenSeaClient) filterEvents(events []Event) ([]Event, FilterCounts) {
+	if len(c.filters) == 0 {
+		return events, nil
+	}
+	var counts FilterCounts
+	kept := events[:0]
+	for _, e := range events {
+		if name, ok := c.drop(e.Asset); ok {
+			counts = counts.add(name)
+			continue
+		}
+		kept = append(kept, e)
+	}
+	return kept, counts
+}
+
+// add counts an item dropped by the named filter, allocating f if needed.
+func (f FilterCounts) add(name string) FilterCounts {
+	if f == nil {
+		f = make(FilterCounts)
+	}
+	f[name]++
+	return f
+}
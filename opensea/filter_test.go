@@ -0,0 +1,191 @@
+package opensea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func newFixtureServer(t *testing.T, fixturePath string) *httptest.Server {
+	data, err := os.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("Failed to read fixture file: %s", err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data)
+	}))
+}
+
+func TestOpenSeaClient_Filters_Assets(t *testing.T) {
+	server := newFixtureServer(t, "../testdata/get_assets.json")
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+		filters:     []Filter{HideHiddenCollections(), HideNotSafelisted(), HidePresale()},
+	}
+	got, err := c.SearchAssets(AssetsQuery{Owner: "0x3b417faee9d2ff636701100891dc2755b5321cc3"})
+	if err != nil {
+		t.Fatalf("OpenSeaClient.SearchAssets() error = %v", err)
+	}
+
+	want := FilterCounts{FilterHiddenCollections: 5, FilterNotSafelisted: 33, FilterPresale: 2}
+	if !reflect.DeepEqual(got.Filtered, want) {
+		t.Errorf("GetAssetsResponse.Filtered = %v, want %v", got.Filtered, want)
+	}
+	if len(got.Assets) != 10 || got.Filtered.Total() != 40 {
+		t.Errorf("OpenSeaClient.SearchAssets() kept %d assets and dropped %d, want 10 and 40", len(got.Assets), got.Filtered.Total())
+	}
+	for _, asset := range got.Assets {
+		if asset.Collection.Hidden || !asset.Collection.IsSafelisted() || asset.IsPresale {
+			t.Errorf("OpenSeaClient.SearchAssets() kept asset %d", asset.ID)
+		}
+	}
+}
+
+func TestOpenSeaClient_Filters_Orders(t *testing.T) {
+	server := newFixtureServer(t, "../testdata/get_orders.json")
+	defer server.Close()
+
+	shared := NewFilter("shared_storefront", func(a Asset) bool {
+		return IsSharedStorefront(a.AssetContract.Address)
+	})
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+		filters:     []Filter{HideNSFW(), shared},
+	}
+	got, err := c.GetCheapestOrders("0x495f947276749ce646f68ac8c248420045cb7b5e", TokenIDFromUint64(1), "1")
+	if err != nil {
+		t.Fatalf("OpenSeaClient.GetCheapestOrders() error = %v", err)
+	}
+	if want := (FilterCounts{"shared_storefront": 1}); !reflect.DeepEqual(got.Filtered, want) {
+		t.Errorf("GetOrdersResponse.Filtered = %v, want %v", got.Filtered, want)
+	}
+	if len(got.Orders) != 1 || got.Count != 2 {
+		t.Fatalf("OpenSeaClient.GetCheapestOrders() = %d orders of %d, want 1 of 2", len(got.Orders), got.Count)
+	}
+	if got.Orders[0].OrderHash != FixtureGetOrdersResp.Orders[1].OrderHash {
+		t.Errorf("OpenSeaClient.GetCheapestOrders() kept order %s", got.Orders[0].OrderHash)
+	}
+}
+
+func TestOpenSeaClient_Filters_Events(t *testing.T) {
+	server := newFixtureServer(t, "../testdata/get_events.json")
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+		filters: []Filter{NewFilter("custom", func(a Asset) bool {
+			return a.TokenID.Equal(FixtureGetEventsResp.AssetEvents[0].Asset.TokenID)
+		})},
+	}
+	got, err := c.GetEvents(EventsQuery{CollectionSlug: "boredapeyachtclub"})
+	if err != nil {
+		t.Fatalf("OpenSeaClient.GetEvents() error = %v", err)
+	}
+	if got.Filtered["custom"] == 0 {
+		t.Fatal("GetEventsResponse.Filtered is empty, want the events of the first asset")
+	}
+	if len(got.AssetEvents)+got.Filtered.Total() != len(FixtureGetEventsResp.AssetEvents) {
+		t.Errorf("OpenSeaClient.GetEvents() kept %d and dropped %d of %d events", len(got.AssetEvents), got.Filtered.Total(), len(FixtureGetEventsResp.AssetEvents))
+	}
+	for _, event := range got.AssetEvents {
+		if event.Asset != nil && event.Asset.TokenID.Equal(FixtureGetEventsResp.AssetEvents[0].Asset.TokenID) {
+			t.Errorf("OpenSeaClient.GetEvents() kept event %d", event.ID)
+		}
+	}
+}
+
+func TestAssetsPaginator_FilteredPage(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			w.Write([]byte(`{"assets": [{"id": 1, "is_nsfw": true}, {"id": 2, "is_nsfw": true}]}`))
+		case "2":
+			w.Write([]byte(`{"assets": [{"id": 3}]}`))
+		default:
+			w.Write([]byte(`{"assets": []}`))
+		}
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 2,
+		pagination:  PaginationOffset,
+		filters:     []Filter{HideNSFW()},
+	}
+	got, err := c.SearchAllAssets(AssetsQuery{Collection: "boredapeyachtclub"})
+	if err != nil {
+		t.Fatalf("OpenSeaClient.SearchAllAssets() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID != 3 {
+		t.Errorf("OpenSeaClient.SearchAllAssets() = %+v, want asset 3", got)
+	}
+	if want := []string{"0", "2", "4"}; !reflect.DeepEqual(offsets, want) {
+		t.Errorf("requested offsets %q, want %q", offsets, want)
+	}
+
+	p := c.NewAssetsPaginator(AssetsQuery{Collection: "boredapeyachtclub"})
+	for p.HasNextPage() {
+		if _, err := p.NextPage(context.Background()); err != nil {
+			t.Fatalf("AssetsPaginator.NextPage() error = %v", err)
+		}
+	}
+	want := FilterCounts{FilterNSFW: 2}
+	if !reflect.DeepEqual(p.Filtered(), want) {
+		t.Errorf("AssetsPaginator.Filtered() = %v, want %v", p.Filtered(), want)
+	}
+
+	it := c.IterateAssets(context.Background(), AssetsQuery{Collection: "boredapeyachtclub"})
+	for it.Next() {
+	}
+	if !reflect.DeepEqual(it.Filtered(), want) {
+		t.Errorf("AssetIterator.Filtered() = %v, want %v", it.Filtered(), want)
+	}
+
+	var last AssetResult
+	for r := range c.StreamAssets(context.Background(), AssetsQuery{Collection: "boredapeyachtclub"}) {
+		last = r
+	}
+	if last.Asset.ID != 3 || !reflect.DeepEqual(last.Filtered, want) {
+		t.Errorf("last AssetResult = asset %d filtered %v, want asset 3 filtered %v", last.Asset.ID, last.Filtered, want)
+	}
+}
+
+func TestHideNotSafelisted(t *testing.T) {
+	drop := HideNotSafelisted().Drop
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{status: SafelistVerified, want: false},
+		{status: SafelistApproved, want: false},
+		{status: SafelistRequested, want: true},
+		{status: SafelistNotRequested, want: true},
+		{status: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := drop(Asset{Collection: Collection{SafelistRequestStatus: tt.status}}); got != tt.want {
+			t.Errorf("HideNotSafelisted() drops status %q = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
@@ -6,11 +6,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -31,16 +31,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/0pD-IeBKWAW0pallZVDAI7xGlnCgoMi2lslEZsKcKJEHb5xUC9Axzl0hJUZWLD_DDhyt-najsHmnvRRiWeYVpaYotTHJU4xu7yEV=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2022-01-13T22:00:33.240873",
+					CreatedDate:             MustParseTimestamp("2022-01-13T22:00:33.240873"),
 					DefaultToFiat:           false,
 					Description:             "The simulation explained through oil paintings. \nWelcome. \n\nEvery 1/1 NFT painting purchase will include the physical copy of the painting onced content has been unlocked. (Only the first person to redeem code will recieve physical copy.)\n\nLuminated Club Black Cards do not include physical paintings, but will include exclusive access to art galleries in the future. Limited 100 Black Card NFTs.\n\nALC Green Cards now available.\n\nAluminatedclub.com",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://Aluminatedclub.com",
@@ -54,8 +54,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "A Luminated Club",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -65,11 +65,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x9b9561e6503b0c5794ee142ad03b68428ec5b0ae",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/14.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Aluminatedclub",
 					},
 				},
@@ -84,24 +84,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "ALC Green Card 3",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "ALC Green Card 3",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/70372427421690915951028887770702625142377674070018570432223787673943344676865",
-				SellOrders:              nil,
-				TokenID:                 "70372427421690915951028887770702625142377674070018570432223787673943344676865",
+				TokenID:                 MustParseTokenID("70372427421690915951028887770702625142377674070018570432223787673943344676865"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -110,11 +107,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -135,16 +132,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/0pD-IeBKWAW0pallZVDAI7xGlnCgoMi2lslEZsKcKJEHb5xUC9Axzl0hJUZWLD_DDhyt-najsHmnvRRiWeYVpaYotTHJU4xu7yEV=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2022-01-13T22:00:33.240873",
+					CreatedDate:             MustParseTimestamp("2022-01-13T22:00:33.240873"),
 					DefaultToFiat:           false,
 					Description:             "The simulation explained through oil paintings. \nWelcome. \n\nEvery 1/1 NFT painting purchase will include the physical copy of the painting onced content has been unlocked. (Only the first person to redeem code will recieve physical copy.)\n\nLuminated Club Black Cards do not include physical paintings, but will include exclusive access to art galleries in the future. Limited 100 Black Card NFTs.\n\nALC Green Cards now available.\n\nAluminatedclub.com",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://Aluminatedclub.com",
@@ -158,8 +155,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "A Luminated Club",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -169,11 +166,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x9b9561e6503b0c5794ee142ad03b68428ec5b0ae",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/14.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Aluminatedclub",
 					},
 				},
@@ -188,24 +185,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "ALC Black Card 02",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "ALC Black Card 02",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/70372427421690915951028887770702625142377674070018570432223787563992181899265",
-				SellOrders:              nil,
-				TokenID:                 "70372427421690915951028887770702625142377674070018570432223787563992181899265",
+				TokenID:                 MustParseTokenID("70372427421690915951028887770702625142377674070018570432223787563992181899265"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -214,11 +208,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -239,16 +233,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/0pD-IeBKWAW0pallZVDAI7xGlnCgoMi2lslEZsKcKJEHb5xUC9Axzl0hJUZWLD_DDhyt-najsHmnvRRiWeYVpaYotTHJU4xu7yEV=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2022-01-13T22:00:33.240873",
+					CreatedDate:             MustParseTimestamp("2022-01-13T22:00:33.240873"),
 					DefaultToFiat:           false,
 					Description:             "The simulation explained through oil paintings. \nWelcome. \n\nEvery 1/1 NFT painting purchase will include the physical copy of the painting onced content has been unlocked. (Only the first person to redeem code will recieve physical copy.)\n\nLuminated Club Black Cards do not include physical paintings, but will include exclusive access to art galleries in the future. Limited 100 Black Card NFTs.\n\nALC Green Cards now available.\n\nAluminatedclub.com",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://Aluminatedclub.com",
@@ -262,8 +256,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "A Luminated Club",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -273,11 +267,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x9b9561e6503b0c5794ee142ad03b68428ec5b0ae",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/14.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Aluminatedclub",
 					},
 				},
@@ -292,24 +286,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "A Luminated Club Android",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "A Luminated Club Android",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/70372427421690915951028887770702625142377674070018570432223787554096577249281",
-				SellOrders:              nil,
-				TokenID:                 "70372427421690915951028887770702625142377674070018570432223787554096577249281",
+				TokenID:                 MustParseTokenID("70372427421690915951028887770702625142377674070018570432223787554096577249281"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -318,11 +309,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x98b486f4fd2a1526eb6fd09f200735d4a9fcadfa",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-10-29T02:48:57.112778",
+					CreatedDate:                 MustParseTimestamp("2021-10-29T02:48:57.112778"),
 					DefaultToFiat:               false,
 					Description:                 "[MINT IS NOW LIVE](https://lilbabydoodlesx.com/)\n\nLIL BABY DOODLES X MUTANT SERUM WILL ONLY BE AIRDROPPED TO ORIGINAL MINT HOLDERS. YOU MUST MINT FROM OUR SITE IN ORDER TO BE ELIGIBLE FOR THE AIRDROP.\n\nLil Baby Doodles X is a collection of 8,888 heavily mutated offspring living in a post apocalyptic wasteland, victims of a terrifying nuclear war... nothing was ever the same.\n\nThe only Doodles Derivative DAO to exist, we Fragmentise blue chip NFTs.\n\nCheck out our LBDX DAO Vault. our community vault already holds a mutant ape... [MAYC #3415](https://opensea.io/LBDX-DAO-VAULT)",
 					DevBuyerFeeBasisPoints:      0,
@@ -343,16 +334,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/spxbOHJO_sehN4AJZ9sRH8s1CYbfD3TLdF25i0ME1_f5e5uH5EokerxM8K_s9xzIYw_VtYVj5zTmXeQslcbBYZjzwSYntQgIkuHhZg=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-10-29T06:19:31.787110",
+					CreatedDate:             MustParseTimestamp("2021-10-29T06:19:31.787110"),
 					DefaultToFiat:           false,
 					Description:             "[MINT IS NOW LIVE](https://lilbabydoodlesx.com/)\n\nLIL BABY DOODLES X MUTANT SERUM WILL ONLY BE AIRDROPPED TO ORIGINAL MINT HOLDERS. YOU MUST MINT FROM OUR SITE IN ORDER TO BE ELIGIBLE FOR THE AIRDROP.\n\nLil Baby Doodles X is a collection of 8,888 heavily mutated offspring living in a post apocalyptic wasteland, victims of a terrifying nuclear war... nothing was ever the same.\n\nThe only Doodles Derivative DAO to exist, we Fragmentise blue chip NFTs.\n\nCheck out our LBDX DAO Vault. our community vault already holds a mutant ape... [MAYC #3415](https://opensea.io/LBDX-DAO-VAULT)",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/aTuX5xvtcj",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "https://lilbabydoodlesx.com",
@@ -366,8 +357,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Lil Baby Doodles X",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x958bb4e56d0a19f9c1e6e33730716000d0f66787",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -377,11 +368,11 @@ var (
 					TwitterUsername:             "NeonDoodlesNFT",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x958bb4e56d0a19f9c1e6e33730716000d0f66787",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/21.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Lil_baby_Doodler_X",
 					},
 				},
@@ -396,24 +387,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "#2064",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "#2064",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x98b486f4fd2a1526eb6fd09f200735d4a9fcadfa/2064",
-				SellOrders:              nil,
-				TokenID:                 "2064",
+				TokenID:                 MustParseTokenID("2064"),
 				TokenMetadata:           "https://lilbabydoodlesx.com/nftdata/2064.json",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -422,11 +410,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x98b486f4fd2a1526eb6fd09f200735d4a9fcadfa",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-10-29T02:48:57.112778",
+					CreatedDate:                 MustParseTimestamp("2021-10-29T02:48:57.112778"),
 					DefaultToFiat:               false,
 					Description:                 "[MINT IS NOW LIVE](https://lilbabydoodlesx.com/)\n\nLIL BABY DOODLES X MUTANT SERUM WILL ONLY BE AIRDROPPED TO ORIGINAL MINT HOLDERS. YOU MUST MINT FROM OUR SITE IN ORDER TO BE ELIGIBLE FOR THE AIRDROP.\n\nLil Baby Doodles X is a collection of 8,888 heavily mutated offspring living in a post apocalyptic wasteland, victims of a terrifying nuclear war... nothing was ever the same.\n\nThe only Doodles Derivative DAO to exist, we Fragmentise blue chip NFTs.\n\nCheck out our LBDX DAO Vault. our community vault already holds a mutant ape... [MAYC #3415](https://opensea.io/LBDX-DAO-VAULT)",
 					DevBuyerFeeBasisPoints:      0,
@@ -447,16 +435,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/spxbOHJO_sehN4AJZ9sRH8s1CYbfD3TLdF25i0ME1_f5e5uH5EokerxM8K_s9xzIYw_VtYVj5zTmXeQslcbBYZjzwSYntQgIkuHhZg=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-10-29T06:19:31.787110",
+					CreatedDate:             MustParseTimestamp("2021-10-29T06:19:31.787110"),
 					DefaultToFiat:           false,
 					Description:             "[MINT IS NOW LIVE](https://lilbabydoodlesx.com/)\n\nLIL BABY DOODLES X MUTANT SERUM WILL ONLY BE AIRDROPPED TO ORIGINAL MINT HOLDERS. YOU MUST MINT FROM OUR SITE IN ORDER TO BE ELIGIBLE FOR THE AIRDROP.\n\nLil Baby Doodles X is a collection of 8,888 heavily mutated offspring living in a post apocalyptic wasteland, victims of a terrifying nuclear war... nothing was ever the same.\n\nThe only Doodles Derivative DAO to exist, we Fragmentise blue chip NFTs.\n\nCheck out our LBDX DAO Vault. our community vault already holds a mutant ape... [MAYC #3415](https://opensea.io/LBDX-DAO-VAULT)",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/aTuX5xvtcj",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "https://lilbabydoodlesx.com",
@@ -470,8 +458,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Lil Baby Doodles X",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x958bb4e56d0a19f9c1e6e33730716000d0f66787",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -481,11 +469,11 @@ var (
 					TwitterUsername:             "NeonDoodlesNFT",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x958bb4e56d0a19f9c1e6e33730716000d0f66787",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/21.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Lil_baby_Doodler_X",
 					},
 				},
@@ -500,24 +488,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "#2063",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "#2063",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x98b486f4fd2a1526eb6fd09f200735d4a9fcadfa/2063",
-				SellOrders:              nil,
-				TokenID:                 "2063",
+				TokenID:                 MustParseTokenID("2063"),
 				TokenMetadata:           "https://lilbabydoodlesx.com/nftdata/2063.json",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -526,11 +511,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xc1c3da23808778df09c49669b2d46484149ee086",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-09-17T00:22:07.803295",
+					CreatedDate:                 MustParseTimestamp("2021-09-17T00:22:07.803295"),
 					DefaultToFiat:               false,
 					Description:                 "Crypt2 (https://crypt2.co.uk) is a collection of unique 60,000 Crypt2 Fruit NFTs— unique digital collectables living on the Ethereum blockchain - Buy a Crypt2 NFT at https://zloadr.com\n\nEach Crypt2 Fruit works as your VIP access card and grants access to members-only benefits, such as exclusive looks at new product launches, event invites, shows, discounts on clothing products, and so much more coming out of Crypt2 Fashion House.\n\nOwners can access more details relating to perks and products shortly via the online store. Visit www.crypt2.co.uk for more information.\n\nJoin the conversation at https://discord.gg/vrEA7GCuJn\n",
 					DevBuyerFeeBasisPoints:      0,
@@ -551,16 +536,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/oIIigDZ366Kw8vcTUl8Go_kvGSViMgBZrS3WiZU5AyXUe2_uhIy1sNZT_1eGwD9tGbkQycfh8-ug9LUGlOXt37B04l1uElvroRrwXLk=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-09-17T10:23:11.269519",
+					CreatedDate:             MustParseTimestamp("2021-09-17T10:23:11.269519"),
 					DefaultToFiat:           false,
 					Description:             "Crypt2 (https://crypt2.co.uk) is a collection of unique 60,000 Crypt2 Fruit NFTs— unique digital collectables living on the Ethereum blockchain - Buy a Crypt2 NFT at https://zloadr.com\n\nEach Crypt2 Fruit works as your VIP access card and grants access to members-only benefits, such as exclusive looks at new product launches, event invites, shows, discounts on clothing products, and so much more coming out of Crypt2 Fashion House.\n\nOwners can access more details relating to perks and products shortly via the online store. Visit www.crypt2.co.uk for more information.\n\nJoin the conversation at https://discord.gg/vrEA7GCuJn\n",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/WRcHCjSwKK",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://crypt2.co.uk",
@@ -574,8 +559,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Crypt2",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x6a3fa00bbdc4669c193a5445e7255e905e386ac3",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -585,11 +570,11 @@ var (
 					TwitterUsername:             "crypt2official",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xac0f86332132caac87c77b4928eea76d2b2fee32",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/27.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
@@ -604,24 +589,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0xc1c3da23808778df09c49669b2d46484149ee086/15",
-				SellOrders:              nil,
-				TokenID:                 "15",
+				TokenID:                 MustParseTokenID("15"),
 				TokenMetadata:           "https://crypt2.co.uk/nfts/meta/traits/15",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -630,11 +612,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/38cbd7d96950dd012dfdcf327aec4b01.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/38cbd7d96950dd012dfdcf327aec4b01.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -655,16 +637,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/CxSRCLQOd0UdqcyIIWCC4DO7ZfC9gESHCdZKTT6szeGSCt8khT0PfeSgH1h8c5gGkQmNzVfOKoEBOckHHFH3G1oDT4riSpgUI29FBg=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-10-18T12:17:12.801152",
+					CreatedDate:             MustParseTimestamp("2021-10-18T12:17:12.801152"),
 					DefaultToFiat:           false,
 					Description:             "#The first NFT Magazine to be read and collected on Ethereum! \n#The issue #04 ISSUE METAVERSE & GAMING with DANGIUZ cover will be available on the 2nd of February 18 CET here and for WHITELIST, PRESALE and BUNDLE on [NEWSSTAND SITE](https://www.thenftmag.io/newsstand/)!\n\nCollect the NFT Covers created by the major international Crypto Artists to read the NFT Magazine.\n\nDiscover the biggest players in the Crypto world, market trends, rankings, and expert advice.\n\nEvery month will be dedicated to Digital Art, Collectibles, Cryptocurrencies, Fintech, and Blockchain.\n\nJoin the “Readers Club”, participate in the creation of the magazine itself and become the protagonist of the next issue.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/MyaNS8YGKy",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://www.thenftmag.io/",
@@ -678,8 +660,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "The NFT Magazine -",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x6da705478b5c1fd9cac9e664015c0db98bd1a3a0",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -689,11 +671,11 @@ var (
 					TwitterUsername:             "thenftmag",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x6da705478b5c1fd9cac9e664015c0db98bd1a3a0",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/31.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "TheNFTmag",
 					},
 				},
@@ -708,100 +690,105 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "49597200392958280165177755798765298064312831948909620000388784438348531893124",
+						TokenID:  MustParseTokenID("49597200392958280165177755798765298064312831948909620000388784438348531893124"),
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "#03 REFIK ANADOL - The NFT Magazine",
-				NumSales:    83,
-				Owner: AssetOwner{
+				Name:     "#03 REFIK ANADOL - The NFT Magazine",
+				NumSales: 83,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink: "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/49597200392958280165177755798765298064312831948909620000388784438348531893124",
-				SellOrders: []interface{}{
-					map[string]interface{}{
-						"metadata": map[string]interface{}{
-							"asset": map[string]interface{}{
-								"id":       "49597200392958280165177755798765298064312831948909620000388784438348531893124",
-								"address":  "0x495f947276749ce646f68ac8c248420045cb7b5e",
-								"quantity": "3",
+				SellOrders: []Order{
+					Order{
+						ApprovedOnChain:   false,
+						BasePrice:         MustParsePrice("236700000000000000", 18),
+						BountyMultiple:    "0.01",
+						Calldata:          "0xf242432a000000000000000000000000409753d7a885abdc28ff470dfa82bc448b683bf400000000000000000000000000000000000000000000000000000000000000006da705478b5c1fd9cac9e664015c0db98bd1a3a0000000000000030000000384000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+						Cancelled:         false,
+						ClosingDate:       MustParseTimestamp("2022-01-30T09:09:59"),
+						ClosingExtendable: false,
+						CreatedDate:       MustParseTimestamp("2022-01-27T09:11:06.928325"),
+						CurrentBounty:     MustParsePrice("2367000000000000", 18),
+						CurrentPrice:      MustParsePrice("236700000000000000.0000000000", 18),
+						Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+						ExpirationTime:    UnixTimestamp(1643533799),
+						Extra:             "0",
+						FeeMethod:         1,
+						FeeRecipient: Account{
+							Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+							Config:        "verified",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+							User: AccountUser{
+								ID: 3585,
 							},
-							"schema": "ERC1155",
 						},
-						"prefixed_hash": "0x00b942524e717cc0a376356b81f9d0f0dac529d86165f8ee81505cd29a856b12",
-						"taker": map[string]interface{}{
-							"user":            1766.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-							"address":         "0x0000000000000000000000000000000000000000",
-							"config":          "",
+						Finalized:   false,
+						HowToCall:   0,
+						ListingTime: UnixTimestamp(1643274550),
+						Maker: Account{
+							Address:       "0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+							User: AccountUser{
+								ID: 1666865,
+							},
 						},
-						"payment_token_contract": map[string]interface{}{
-							"id":        1.000000,
-							"symbol":    "ETH",
-							"address":   "0x0000000000000000000000000000000000000000",
-							"image_url": "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
-							"name":      "Ether",
-							"decimals":  18.000000,
-							"eth_price": "1.000000000000000",
-							"usd_price": "2454.469999999999800000",
+						MakerProtocolFee: "0",
+						MakerReferrerFee: "0",
+						MakerRelayerFee:  "1250",
+						MarkedInvalid:    false,
+						Metadata: OrderMetadata{
+							Asset: OrderMetadataAsset{
+								Address:  "0x495f947276749ce646f68ac8c248420045cb7b5e",
+								ID:       MustParseTokenID("49597200392958280165177755798765298064312831948909620000388784438348531893124"),
+								Quantity: "3",
+							},
+							Schema: "ERC1155",
 						},
-						"closing_extendable":  false,
-						"expiration_time":     1643533799.000000,
-						"target":              "0x495f947276749ce646f68ac8c248420045cb7b5e",
-						"replacement_pattern": "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-						"current_bounty":      "2367000000000000",
-						"maker_referrer_fee":  "0",
-						"how_to_call":         0.000000,
-						"payment_token":       "0x0000000000000000000000000000000000000000",
-						"extra":               "0",
-						"r":                   "0x9d5aa554697b990bb7ff986deee5a7cef3c05062d0869dd00432c518deeb6a3f",
-						"maker_protocol_fee":  "0",
-						"static_extradata":    "0x",
-						"salt":                "26535559511018809092298610860233562045941284456400966490545275593494424472844",
-						"created_date":        "2022-01-27T09:11:06.928325",
-						"closing_date":        "2022-01-30T09:09:59",
-						"exchange":            "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
-						"bounty_multiple":     "0.01",
-						"maker_relayer_fee":   "1250",
-						"fee_method":          1.000000,
-						"side":                1.000000,
-						"sale_kind":           0.000000,
-						"calldata":            "0xf242432a000000000000000000000000409753d7a885abdc28ff470dfa82bc448b683bf400000000000000000000000000000000000000000000000000000000000000006da705478b5c1fd9cac9e664015c0db98bd1a3a0000000000000030000000384000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
-						"quantity":            "3",
-						"s":                   "0x4113d8ecd62a4364f7ec63bca167351f4907010265c615302d4bb500bbf23b24",
-						"finalized":           false,
-						"order_hash":          "0x6082b1dd3bb5ec23e19aba6c9d7c4ab980cd5374b1a6cb05b990fb0d094bd01f",
-						"taker_protocol_fee":  "0",
-						"fee_recipient": map[string]interface{}{
-							"config":          "verified",
-							"user":            3585.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
-							"address":         "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+						OrderHash:    "0x6082b1dd3bb5ec23e19aba6c9d7c4ab980cd5374b1a6cb05b990fb0d094bd01f",
+						PaymentToken: "0x0000000000000000000000000000000000000000",
+						PaymentTokenContract: PaymentToken{
+							Address:  "0x0000000000000000000000000000000000000000",
+							Decimals: intPtr(18),
+							EthPrice: "1.000000000000000",
+							ID:       1,
+							ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+							Name:     "Ether",
+							Symbol:   "ETH",
+							UsdPrice: "2454.469999999999800000",
 						},
-						"v":            27.000000,
-						"listing_time": 1643274550.000000,
-						"maker": map[string]interface{}{
-							"user":            1666865.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-							"address":         "0x409753d7a885abdc28ff470dfa82bc448b683bf4",
-							"config":          "",
+						PrefixedHash:       "0x00b942524e717cc0a376356b81f9d0f0dac529d86165f8ee81505cd29a856b12",
+						Quantity:           "3",
+						R:                  "0x9d5aa554697b990bb7ff986deee5a7cef3c05062d0869dd00432c518deeb6a3f",
+						ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+						S:                  "0x4113d8ecd62a4364f7ec63bca167351f4907010265c615302d4bb500bbf23b24",
+						SaleKind:           0,
+						Salt:               "26535559511018809092298610860233562045941284456400966490545275593494424472844",
+						Side:               1,
+						StaticExtradata:    "0x",
+						StaticTarget:       "0x0000000000000000000000000000000000000000",
+						Taker: Account{
+							Address:       "0x0000000000000000000000000000000000000000",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+							User: AccountUser{
+								ID: 1766,
+							},
 						},
-						"current_price":     "236700000000000000.0000000000",
-						"taker_relayer_fee": "0",
-						"static_target":     "0x0000000000000000000000000000000000000000",
-						"base_price":        "236700000000000000",
-						"approved_on_chain": false,
-						"cancelled":         false,
-						"marked_invalid":    false,
+						TakerProtocolFee: "0",
+						TakerRelayerFee:  "0",
+						Target:           "0x495f947276749ce646f68ac8c248420045cb7b5e",
+						V:                27,
 					},
 				},
-				TokenID:                 "49597200392958280165177755798765298064312831948909620000388784438348531893124",
+				TokenID:                 MustParseTokenID("49597200392958280165177755798765298064312831948909620000388784438348531893124"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -810,11 +797,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x26badf693f2b103b021c670c852262b379bbbe8a",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-12-07T04:51:25.833082",
+					CreatedDate:                 MustParseTimestamp("2021-12-07T04:51:25.833082"),
 					DefaultToFiat:               false,
 					Description:                 "DAO Balance: 1,024.65 ETH | Last Updated 1/20/22\n\n----\n\nIlluminatiNFT is a collection of 8,128 generative NFTs. 50% of the initial mint and secondary royalties go into the [Illuminati Collective DAO](https://etherscan.io/address/0xa43653fdab0c0967ab8f9cd7d84b3205a9315b03), a governance DAO for the community\n\nEach IlluminatiNFT doubles as a vote for activations and experiences paid for by the Illuminati Collective DAO and grants exclusive access into our [community](https://discord.com/invite/illuminati)\n\n----\n\nWe are the stern prescient, the unrepentant present who enter the secret and serpentine nests of KNOWLEDGE and pursue the tenets of the TRUTH\n\nWe are the knowing unknown. We are those who REMAIN\n\nAfter centuries of ritual, calculation, sacrifice, and research, we present to you few:\nThe Illuminati Non-Fungible Token—the COUNTERSIGN for a secret society on the blockchain\n\nIf you wish to see the TRUTH, if you wish to take your place in the CIRCLE, you must be brave enough to look",
 					DevBuyerFeeBasisPoints:      0,
@@ -835,16 +822,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/WGO25nS0pXt_TqIDiFity0wDKerZ0bfo1Qow75oUBGnYv3dYZITHa33bEAEw8FkpGgldeA7CR93uD4qWv9mdEvB_rWj8K21EOteMpA=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-12-07T04:59:27.767502",
+					CreatedDate:             MustParseTimestamp("2021-12-07T04:59:27.767502"),
 					DefaultToFiat:           false,
 					Description:             "DAO Balance: 1,024.65 ETH | Last Updated 1/20/22\n\n----\n\nIlluminatiNFT is a collection of 8,128 generative NFTs. 50% of the initial mint and secondary royalties go into the [Illuminati Collective DAO](https://etherscan.io/address/0xa43653fdab0c0967ab8f9cd7d84b3205a9315b03), a governance DAO for the community\n\nEach IlluminatiNFT doubles as a vote for activations and experiences paid for by the Illuminati Collective DAO and grants exclusive access into our [community](https://discord.com/invite/illuminati)\n\n----\n\nWe are the stern prescient, the unrepentant present who enter the secret and serpentine nests of KNOWLEDGE and pursue the tenets of the TRUTH\n\nWe are the knowing unknown. We are those who REMAIN\n\nAfter centuries of ritual, calculation, sacrifice, and research, we present to you few:\nThe Illuminati Non-Fungible Token—the COUNTERSIGN for a secret society on the blockchain\n\nIf you wish to see the TRUTH, if you wish to take your place in the CIRCLE, you must be brave enough to look",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/illuminati",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://www.illuminatinft.com/",
@@ -858,8 +845,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "IlluminatiNFT",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x0aa1f3d61e7c325ae795737266c5fd6839819b86",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -869,11 +856,11 @@ var (
 					TwitterUsername:             "truth",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x0aa1f3d61e7c325ae795737266c5fd6839819b86",
 					Config:        "verified",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/26.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "IlluminatiCollective",
 					},
 				},
@@ -888,24 +875,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Illuminati #2301",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Illuminati #2301",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x26badf693f2b103b021c670c852262b379bbbe8a/2301",
-				SellOrders:              nil,
-				TokenID:                 "2301",
+				TokenID:                 MustParseTokenID("2301"),
 				TokenMetadata:           "https://gateway.pinata.cloud/ipfs/QmfZdNWuwnNNkBnVdXMJe9sQtR1bGkx3CAkKu62KwLTJuG/2301",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -914,11 +898,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x5d9bcfd727ab6a4a83bb3607286806a362d1fef1",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-12-10T04:13:51.292284",
+					CreatedDate:                 MustParseTimestamp("2021-12-10T04:13:51.292284"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -939,16 +923,16 @@ var (
 					TotalSupply:                 "20",
 				},
 				BackgroundColor: "000000",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/bP2e-LSGoWWD2d-cLZVLtUE4njn0UA1KJ77to1IEXd8dUdaeUJBBTxrEdAv5t22N3Mhj60zmxMpb4Ljt3cAWd8m-6q8OoRC-JCUNQw=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-12-10T04:14:00.143882",
+					CreatedDate:             MustParseTimestamp("2021-12-10T04:14:00.143882"),
 					DefaultToFiat:           false,
 					Description:             "",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "https://rightcopy.io",
@@ -962,8 +946,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Rightcopy",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -973,11 +957,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x39d60e114e49d1465196016b15aaa4951b665fa1",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/8.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Rightcopy",
 					},
 				},
@@ -992,24 +976,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Tidal",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Tidal",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x5d9bcfd727ab6a4a83bb3607286806a362d1fef1/4",
-				SellOrders:              nil,
-				TokenID:                 "4",
+				TokenID:                 MustParseTokenID("4"),
 				TokenMetadata:           "https://storageapi.fleek.co/rockomatthews-team-bucket/metadata/4.json",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1018,11 +999,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/28a0dbe33e92e70fa73f9a71282dd87d.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/28a0dbe33e92e70fa73f9a71282dd87d.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -1043,16 +1024,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/EGLCOgCPT2H-sePB6frqakg7aVLX-2Np69GC04wKp3tiAqc95pB5amMypOjxZcWd567y4UiOH0LaVy0NfXQe8ntVbUZ_gSiQJJb7=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-12-07T22:42:49.741757",
+					CreatedDate:             MustParseTimestamp("2021-12-07T22:42:49.741757"),
 					DefaultToFiat:           false,
 					Description:             "A purchase from this collection is real PROOF that you have one of the very first copies of this song ever created. All songs sold in this collection will be previously unreleased before minted here. Unsold copies if any will be disabled or burned when possible, after the song becomes available for streaming.\n\nAll music in this collection is authorized for sale by the original artist or record label. The artist or label is financially participating in profits from this sale. \n\n© Copyright and ℗ Sound recording rights are maintained by the original owner. This is not a license for public performance, sync or replication of any kind. (Unique graphics are designed by Chris Villareal for SoSouth)\n\n**No music business executives were harmed in the making of this NFT collection. (…maybe a little)**\n\nWatch for more new prereleases soon.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://sosouth.com",
@@ -1066,8 +1047,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "PROOF song mint by SoSouth",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xe2dfd2fff3de7856a8c7c67739d747310010eead",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1077,11 +1058,11 @@ var (
 					TwitterUsername:             "sosouth",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xe2dfd2fff3de7856a8c7c67739d747310010eead",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/11.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "SoSouth",
 					},
 				},
@@ -1096,24 +1077,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Lil’ Keke “We From Texas” #25 of 25 proof#0025",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Lil’ Keke “We From Texas” #25 of 25 proof#0025",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/102618166942465415374602110791470112039116595012260140683807734293420383928321",
-				SellOrders:              nil,
-				TokenID:                 "102618166942465415374602110791470112039116595012260140683807734293420383928321",
+				TokenID:                 MustParseTokenID("102618166942465415374602110791470112039116595012260140683807734293420383928321"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1122,11 +1100,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -1147,16 +1125,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/JX6FmBSiK1mdLrnEcgOKiR1xSL_drehrBiAdNW8KLpC7_4hbA60tIrPnvwjihjZtr3Jn3pV38bnaASYXYFCVpen5asnIFywN1_p7RA=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-11-17T01:57:04.135338",
+					CreatedDate:             MustParseTimestamp("2021-11-17T01:57:04.135338"),
 					DefaultToFiat:           false,
 					Description:             "THE ULTIMATE TEAM OF GOATS FROM THE METAVERSE !! \n \n ALL 1 OF 1’s\n\n\nA collection of META_GOATS from the Metaverse with a mission... which will be revealed once the entire TEAM has been SOLD Get one now, before they're all gone.\n\nWhen you buy an NFT, you are paying for a token that represents an asset. The token carries the information of the asset that proves its authenticity and that you own limited access to that digital record.\n",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "padded",
 					},
 					ExternalURL:                 "",
@@ -1170,8 +1148,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "META_GOATS",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xc5bcd3211df6fe4e5efbee82ef1c57b0db90ccbc",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1181,11 +1159,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xc5bcd3211df6fe4e5efbee82ef1c57b0db90ccbc",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/16.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "META_GOATS_DEPLOYER",
 					},
 				},
@@ -1200,24 +1178,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "EASY",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "EASY",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/89439255580394891231306374729619897719071975630374435653408931966608589455361",
-				SellOrders:              nil,
-				TokenID:                 "89439255580394891231306374729619897719071975630374435653408931966608589455361",
+				TokenID:                 MustParseTokenID("89439255580394891231306374729619897719071975630374435653408931966608589455361"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1226,11 +1201,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -1251,16 +1226,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/gz0rv_3JI99ZvkXd80Lj6ksWmL9eUMjkH6Jv9B7Kl3WGBo34m8_b7cUCUl-fUiVGgz9WIqI6keY1tTqjUyQ4qDrr0DTKF6lrJXg=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-11-09T00:41:03.906658",
+					CreatedDate:             MustParseTimestamp("2021-11-09T00:41:03.906658"),
 					DefaultToFiat:           false,
 					Description:             "GigaChicks is a collection of 1,000 individually made and named NFTs of pixelated \"Chicks.\" ",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/TANbwue5",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://gigachicks.io",
@@ -1274,8 +1249,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "GigaChicks",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x0315e3686f07552d2faf1e32d75422326c4bf857",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1285,11 +1260,11 @@ var (
 					TwitterUsername:             "Giga_Chicks",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x0315e3686f07552d2faf1e32d75422326c4bf857",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/4.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "SellyKyub",
 					},
 				},
@@ -1304,24 +1279,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Jay #099",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Jay #099",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/1395611846058000959245217618999531258205369926781007341800442914236111257601",
-				SellOrders:              nil,
-				TokenID:                 "1395611846058000959245217618999531258205369926781007341800442914236111257601",
+				TokenID:                 MustParseTokenID("1395611846058000959245217618999531258205369926781007341800442914236111257601"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1330,11 +1302,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x98b486f4fd2a1526eb6fd09f200735d4a9fcadfa",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-10-29T02:48:57.112778",
+					CreatedDate:                 MustParseTimestamp("2021-10-29T02:48:57.112778"),
 					DefaultToFiat:               false,
 					Description:                 "[MINT IS NOW LIVE](https://lilbabydoodlesx.com/)\n\nLIL BABY DOODLES X MUTANT SERUM WILL ONLY BE AIRDROPPED TO ORIGINAL MINT HOLDERS. YOU MUST MINT FROM OUR SITE IN ORDER TO BE ELIGIBLE FOR THE AIRDROP.\n\nLil Baby Doodles X is a collection of 8,888 heavily mutated offspring living in a post apocalyptic wasteland, victims of a terrifying nuclear war... nothing was ever the same.\n\nThe only Doodles Derivative DAO to exist, we Fragmentise blue chip NFTs.\n\nCheck out our LBDX DAO Vault. our community vault already holds a mutant ape... [MAYC #3415](https://opensea.io/LBDX-DAO-VAULT)",
 					DevBuyerFeeBasisPoints:      0,
@@ -1355,16 +1327,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/spxbOHJO_sehN4AJZ9sRH8s1CYbfD3TLdF25i0ME1_f5e5uH5EokerxM8K_s9xzIYw_VtYVj5zTmXeQslcbBYZjzwSYntQgIkuHhZg=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-10-29T06:19:31.787110",
+					CreatedDate:             MustParseTimestamp("2021-10-29T06:19:31.787110"),
 					DefaultToFiat:           false,
 					Description:             "[MINT IS NOW LIVE](https://lilbabydoodlesx.com/)\n\nLIL BABY DOODLES X MUTANT SERUM WILL ONLY BE AIRDROPPED TO ORIGINAL MINT HOLDERS. YOU MUST MINT FROM OUR SITE IN ORDER TO BE ELIGIBLE FOR THE AIRDROP.\n\nLil Baby Doodles X is a collection of 8,888 heavily mutated offspring living in a post apocalyptic wasteland, victims of a terrifying nuclear war... nothing was ever the same.\n\nThe only Doodles Derivative DAO to exist, we Fragmentise blue chip NFTs.\n\nCheck out our LBDX DAO Vault. our community vault already holds a mutant ape... [MAYC #3415](https://opensea.io/LBDX-DAO-VAULT)",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/aTuX5xvtcj",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "https://lilbabydoodlesx.com",
@@ -1378,8 +1350,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Lil Baby Doodles X",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x958bb4e56d0a19f9c1e6e33730716000d0f66787",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1389,11 +1361,11 @@ var (
 					TwitterUsername:             "NeonDoodlesNFT",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x958bb4e56d0a19f9c1e6e33730716000d0f66787",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/21.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Lil_baby_Doodler_X",
 					},
 				},
@@ -1408,24 +1380,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "#9",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "#9",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x98b486f4fd2a1526eb6fd09f200735d4a9fcadfa/9",
-				SellOrders:              nil,
-				TokenID:                 "9",
+				TokenID:                 MustParseTokenID("9"),
 				TokenMetadata:           "https://lilbabydoodlesx.com/nftdata/9.json",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1434,11 +1403,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x837abada0fee61105005e6fae41507e3eda23739",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-10-05T17:30:36.764813",
+					CreatedDate:                 MustParseTimestamp("2021-10-05T17:30:36.764813"),
 					DefaultToFiat:               false,
 					Description:                 "The Adventurers is the first hybrid NFT derived from text trait properties. The Adventurers Avatar is RPG style NFT with 4 Classes and 2 Races.  Full body hero NFT will be free for all  PFP Avatar NFT holders.",
 					DevBuyerFeeBasisPoints:      0,
@@ -1459,16 +1428,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/JHMDLoFz6fzNKtnsLc9fF8LnkQx8jo9eLRU56Ck_GF6KNEtLWCOXRotd7vra6oD3mWuzGetjzLh_v2HPAggSBI3dQr3xXwABtpU4=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-10-05T20:36:48.919399",
+					CreatedDate:             MustParseTimestamp("2021-10-05T20:36:48.919399"),
 					DefaultToFiat:           false,
 					Description:             "The Adventurers is the first hybrid NFT derived from text trait properties. The Adventurers Avatar is RPG style NFT with 4 Classes and 2 Races.  Full body hero NFT will be free for all  PFP Avatar NFT holders.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/HpeYCYywUR",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://www.theadventurers.io",
@@ -1482,8 +1451,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "The Adventurers Avatar V2",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x84a95e7814fce8faaea6eeeaafc7c0d163e574b1",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1493,11 +1462,11 @@ var (
 					TwitterUsername:             "Adventurersio",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x84a95e7814fce8faaea6eeeaafc7c0d163e574b1",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/16.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
@@ -1512,24 +1481,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "The Adventurers Avatar #3970",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "The Adventurers Avatar #3970",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x837abada0fee61105005e6fae41507e3eda23739/3970",
-				SellOrders:              nil,
-				TokenID:                 "3970",
+				TokenID:                 MustParseTokenID("3970"),
 				TokenMetadata:           "https://www.theadventurers.io/api/3970",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1538,11 +1504,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x93ac7adad123d58fa40c583f85daec136c0ac78a",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-10-01T15:44:22.747517",
+					CreatedDate:                 MustParseTimestamp("2021-10-01T15:44:22.747517"),
 					DefaultToFiat:               false,
 					Description:                 "The Shadow Legion is 5K NFT collection of Orcs and Undead warriors! Take up arms against the Adventurers Guild!\r\n\r\nShadow Legion NFTs will be able to claim a Full body Artwork NFT, corresponding to each character.",
 					DevBuyerFeeBasisPoints:      0,
@@ -1563,16 +1529,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/C1k3MGmFqzwt-G726jdSvXdFpSE4zrZAtZ_lYulRiDcpVjJt0AsjCOrc4XZ4yvSf6TGI_kVDMHCxBpFffFj-GP0x66qEw94MvUwytYU=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-10-01T20:50:29.290254",
+					CreatedDate:             MustParseTimestamp("2021-10-01T20:50:29.290254"),
 					DefaultToFiat:           false,
 					Description:             "The Shadow Legion is 5K NFT collection of Orcs and Undead warriors! Take up arms against the Adventurers Guild!\r\n\r\nShadow Legion NFTs will be able to claim a Full body Artwork NFT, corresponding to each character.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/fTYvcpfNgz",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://shadowlegion.io",
@@ -1586,8 +1552,8 @@ var (
 					MediumUsername:              "theadventurersio",
 					Name:                        "Shadow Legion",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x84a95e7814fce8faaea6eeeaafc7c0d163e574b1",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1597,11 +1563,11 @@ var (
 					TwitterUsername:             "ShadowLegionADV",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x84a95e7814fce8faaea6eeeaafc7c0d163e574b1",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/16.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
@@ -1616,24 +1582,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "The Adventurers Avatar #5343",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "The Adventurers Avatar #5343",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x93ac7adad123d58fa40c583f85daec136c0ac78a/5343",
-				SellOrders:              nil,
-				TokenID:                 "5343",
+				TokenID:                 MustParseTokenID("5343"),
 				TokenMetadata:           "https://ipfs.io/ipfs/QmTfZGXtjkqGukkNCzaYnJ83a5n2MditN16d63B93ACeJ7/5343",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1642,11 +1605,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -1667,16 +1630,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "",
 					ChatURL:                 "",
-					CreatedDate:             "2021-09-22T19:26:31.955828",
+					CreatedDate:             MustParseTimestamp("2021-09-22T19:26:31.955828"),
 					DefaultToFiat:           false,
 					Description:             "Welcome to Bubu Emojis, a one of a kind that provides emojis from Africa. \n\nWe believe that emojis should be diverse and that's why we have created a new line of emojis that better represent the vibrant culture of the African continent.\n\nThere are many emojis to represent European, American, and Asian cultures, however despite population of over 1.1 billion - and a particularly youthful population- there are surprisingly few NFTs emojis for Africans.\n\nBubu emojis were designed, and created by Africans to highlight the ethnically diverse nature of our continent and to make communication between cultures easy, entertaining and rewarding.\n\nWe also want to highlight the difficulties and challenges that Africa faces, as well as our vibrant and colourful culture, the hope and resilience of our people, many of whom look positively towards their futures.\n\n10% of sales will be donated to animal shelters in Africa. Get your Bubu! \n",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -1690,8 +1653,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Bubu Emoji",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xef3d865cfba053c3e7b65f45788fabee00e2226e",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1701,11 +1664,11 @@ var (
 					TwitterUsername:             "https://twitter.com/bubu_elephant",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xef3d865cfba053c3e7b65f45788fabee00e2226e",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/18.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "BubuElephant",
 					},
 				},
@@ -1720,24 +1683,22 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "108211475823177051575410218100179610721706147378660343307493524282411501749224",
+						TokenID:  MustParseTokenID("108211475823177051575410218100179610721706147378660343307493524282411501749224"),
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Bubu Emoji #0013",
-				NumSales:    1,
-				Owner: AssetOwner{
+				Name:     "Bubu Emoji #0013",
+				NumSales: 1,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/108211475823177051575410218100179610721706147378660343307493524282411501749224",
-				SellOrders:              nil,
-				TokenID:                 "108211475823177051575410218100179610721706147378660343307493524282411501749224",
+				TokenID:                 MustParseTokenID("108211475823177051575410218100179610721706147378660343307493524282411501749224"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1746,11 +1707,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/9be7737794947c03497a9d2aba3c2da6.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/9be7737794947c03497a9d2aba3c2da6.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -1771,16 +1732,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/o92MVWAE-ONv9YaX3uZToKYgSsi73nrpMoMCsoqeklbTSHm-ghA0Tw3-LkaWTb1n9TCmBeFX8r-Cv4h6D1m6PlsVxPy1H57_Zmh0vQ=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-09-22T13:11:42.121613",
+					CreatedDate:             MustParseTimestamp("2021-09-22T13:11:42.121613"),
 					DefaultToFiat:           false,
 					Description:             "'OTH' is the Genesis collection from Church. Each picture gives you exclusive access to the 'OTH' WORLD . A DAO global network finessing to retain our last god given freedom which is being taken away from us systematically. Each picture is your ticket to the table. Join the OTH DAO ",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/3vdcbyfQR6",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -1794,8 +1755,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "OTH by Church",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x26218f7e7954a55a561ea1a66cf6c31867f0c0ad",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1805,11 +1766,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x26218f7e7954a55a561ea1a66cf6c31867f0c0ad",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/20.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Churche",
 					},
 				},
@@ -1824,24 +1785,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Cancelled Neon 020",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Cancelled Neon 020",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/17247184558760289152092055812267319703532922514898578846474858600051102449665",
-				SellOrders:              nil,
-				TokenID:                 "17247184558760289152092055812267319703532922514898578846474858600051102449665",
+				TokenID:                 MustParseTokenID("17247184558760289152092055812267319703532922514898578846474858600051102449665"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1850,11 +1808,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -1875,16 +1833,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/HyTX2cmz5g8iFv8XalTLhE7O2vHlL7OX5Jy4fY4Zhx9U5mLLoTgI0NKoTs_9Ga247gVfn_ybgzbdgZcGpJ464WrX3Lo8DdPagAllsg=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-09-22T11:43:57.874705",
+					CreatedDate:             MustParseTimestamp("2021-09-22T11:43:57.874705"),
 					DefaultToFiat:           false,
 					Description:             "The folders collection comprises of folders you may currently find on your desktop or have once upon a time had on your desktop. \n\nDisclaimer: Not affiliated with Apple",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -1898,8 +1856,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "-Folders- Collection",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xd77849eb350f36a1cf996ff92a564291668d8070",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -1909,11 +1867,11 @@ var (
 					TwitterUsername:             "FoldersNFT",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xd77849eb350f36a1cf996ff92a564291668d8070",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/27.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "-Folders-",
 					},
 				},
@@ -1928,24 +1886,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Jay Z #25",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Jay Z #25",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/97459794261834272002253101039427585177472308447617100925122233650348470304769",
-				SellOrders:              nil,
-				TokenID:                 "97459794261834272002253101039427585177472308447617100925122233650348470304769",
+				TokenID:                 MustParseTokenID("97459794261834272002253101039427585177472308447617100925122233650348470304769"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -1954,11 +1909,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xc787d7e5a33caaad31a1ae3c453f955142de145d",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-09-17T17:58:46.017816",
+					CreatedDate:                 MustParseTimestamp("2021-09-17T17:58:46.017816"),
 					DefaultToFiat:               false,
 					Description:                 "The Adventurers is the first hybrid NFT derived from text trait properties inspired by RPGs and anime and are combined with an evolving artwork visually detailing each hero. Each NFT is considered a scroll representing each hero in text format. The NFT is free to encourage an inclusive participation benefiting the wider NFT community. Hero PFP Avatar NFTs will be free for all 5000 text trait NFT holders. Full body hero NFT will be free for all  PFP Avatar NFT holders.",
 					DevBuyerFeeBasisPoints:      0,
@@ -1979,16 +1934,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/SH4_jOGNF_w1iV9oNCLzlns-s1VBtLRo1P-4zgKUMd8hbgbJg8RE3nis33Nv97qtkJgy_H6og-FU3qSD36pVnkTyhwRvHMML4XVcZw=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-09-17T18:10:32.631614",
+					CreatedDate:             MustParseTimestamp("2021-09-17T18:10:32.631614"),
 					DefaultToFiat:           false,
 					Description:             "The Adventurers is the first hybrid NFT derived from text trait properties inspired by RPGs and anime and are combined with an evolving artwork visually detailing each hero. Each NFT is considered a scroll representing each hero in text format. The NFT is free to encourage an inclusive participation benefiting the wider NFT community. Hero PFP Avatar NFTs will be free for all 5000 text trait NFT holders. Full body hero NFT will be free for all  PFP Avatar NFT holders.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/xDMzKvT3dz",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://www.theadventurers.io/",
@@ -2002,8 +1957,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "The Adventurers Text",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x84a95e7814fce8faaea6eeeaafc7c0d163e574b1",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -2013,11 +1968,11 @@ var (
 					TwitterUsername:             "Adventurersio",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x84a95e7814fce8faaea6eeeaafc7c0d163e574b1",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/16.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
@@ -2032,24 +1987,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "The Adventurers Text #4313",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "The Adventurers Text #4313",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0xc787d7e5a33caaad31a1ae3c453f955142de145d/4313",
-				SellOrders:              nil,
-				TokenID:                 "4313",
+				TokenID:                 MustParseTokenID("4313"),
 				TokenMetadata:           "https://www.theadventurers.io/api/4313",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -2058,11 +2010,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -2083,16 +2035,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/ONKTgYvhvcCWBp8GzLVPekndiuqN7zGbfxiNLEiHaPfEYJCXWsvyNaJlMElwv-0NPuNVdPgj0G4eFC2KiBoGfvTV-14qWaKkADIjIA=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-09-14T02:19:25.110018",
+					CreatedDate:             MustParseTimestamp("2021-09-14T02:19:25.110018"),
 					DefaultToFiat:           false,
 					Description:             "The official release of our Generated Collection (8888 Bitboys) will be the 1st of October ! \nOn this epic journey we are happy to present to you our Bitboys \n(cryptopunks exclusive and first editions)\nThe cryptopunks and first editions will have access to the Jade Tower the highest point on Mountain top our decentralized capital. \nCome make your own story, explore, gather, farm, socialize and sell or buy nfts. The world will constantly expand for new adventures.\nThe team has a strong vision of where OneMask is going. \nWe are Pro-Decentralization, depending on where we are in the project we will include decentralized voting for adventures and other aspects of the game.\nIt's not about us, it's about all of us in a decentralized world. \nCome see our Roadmap on our websites for more info !\nPeace !",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "250",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 250,
 					DiscordURL:              "https://discord.gg/uvmetunb",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://www.onemask.io",
@@ -2106,8 +2058,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "OneMask | The Bitboys Club",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x778882e03cec9d5f2b09ec9fa0b7fef0e3961a0c",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -2117,11 +2069,11 @@ var (
 					TwitterUsername:             "onemask_nft",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x778882e03cec9d5f2b09ec9fa0b7fef0e3961a0c",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/10.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "OneMask",
 					},
 				},
@@ -2136,24 +2088,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "One Mask | Bitboys #0002",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "One Mask | Bitboys #0002",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/54066423454687771855932887420213631269938392966461619432630067197043149897729",
-				SellOrders:              nil,
-				TokenID:                 "54066423454687771855932887420213631269938392966461619432630067197043149897729",
+				TokenID:                 MustParseTokenID("54066423454687771855932887420213631269938392966461619432630067197043149897729"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -2162,11 +2111,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xa9cb55d05d3351dcd02dd5dc4614e764ce3e1d6e",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-09-11T11:39:54.014502",
+					CreatedDate:                 MustParseTimestamp("2021-09-11T11:39:54.014502"),
 					DefaultToFiat:               false,
 					Description:                 "The Meta Reserve (AKA WeMint Washington) sales volume now exceeds $2 Million and growing. The project intends to be a currency utilized in the metaverse.\n\n***NOTE: Series II minting is live! If you wish to mint a Series II for free, please check Series I eligibility here: https://www.wemint.cash/\n\n\nThe Meta Reserve Series I: https://opensea.io/collection/wemint-washington\n\nThe Meta Reserve Series II: https://opensea.io/collection/wemint-jefferson\n\nThe Meta Reserve Series III: Coming Soon\n\n\nMint a Jefferson Site: https://www.wemint.cash\n\nCost to Mint a Jefferson: Own one (1) https://opensea.io/collection/wemint-washington\n\n\nProject Website: https://www.TheMetaReserve.com\n\nDiscord: https://discord.gg/TheMetaReserve\n\nInstagram: https://www.instagram.com/themetareserve\n\nReddit: https://www.reddit.com/r/TheMetaReserve\n\nTikTok: https://www.tiktok.com/@themetareserve\n\nTwitter: https://twitter.com/wemintcash",
 					DevBuyerFeeBasisPoints:      0,
@@ -2187,16 +2136,16 @@ var (
 					TotalSupply:                 "10000",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/kiYcxWF4diCwaWq6kFmTwFA-1F3eO9k_dZUJo8so6rxJsrnobFO2EUkTOMDb41A3WN8OwHs61Bsz2EVSayCJPJ6NhFz8VDcsG0uJsQ=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-09-11T11:39:57.060698",
+					CreatedDate:             MustParseTimestamp("2021-09-11T11:39:57.060698"),
 					DefaultToFiat:           false,
 					Description:             "The Meta Reserve (AKA WeMint Washington) sales volume now exceeds $2 Million and growing. The project intends to be a currency utilized in the metaverse.\n\n***NOTE: Series II minting is live! If you wish to mint a Series II for free, please check Series I eligibility here: https://www.wemint.cash/\n\n\nThe Meta Reserve Series I: https://opensea.io/collection/wemint-washington\n\nThe Meta Reserve Series II: https://opensea.io/collection/wemint-jefferson\n\nThe Meta Reserve Series III: Coming Soon\n\n\nMint a Jefferson Site: https://www.wemint.cash\n\nCost to Mint a Jefferson: Own one (1) https://opensea.io/collection/wemint-washington\n\n\nProject Website: https://www.TheMetaReserve.com\n\nDiscord: https://discord.gg/TheMetaReserve\n\nInstagram: https://www.instagram.com/themetareserve\n\nReddit: https://www.reddit.com/r/TheMetaReserve\n\nTikTok: https://www.tiktok.com/@themetareserve\n\nTwitter: https://twitter.com/wemintcash",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/TheMetaReserve",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "https://www.themetareserve.com/",
@@ -2210,8 +2159,8 @@ var (
 					MediumUsername:              "wemintcash",
 					Name:                        "WeMint Washington",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xf1b2c3ce2c4a6df988a58fccef2ae7dfa4eb7252",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "verified",
@@ -2221,11 +2170,11 @@ var (
 					TwitterUsername:             "wemintcash",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "",
 					Config:        "",
 					ProfileImgURL: "",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
@@ -2240,24 +2189,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Washington #4030",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Washington #4030",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0xa9cb55d05d3351dcd02dd5dc4614e764ce3e1d6e/4030",
-				SellOrders:              nil,
-				TokenID:                 "4030",
+				TokenID:                 MustParseTokenID("4030"),
 				TokenMetadata:           "https://ipfs.io/ipfs/QmcmxU24WqUwoFeXrnU1PWYW4BbxwDmKmgprJ3yesxsZLj/4030.json",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -2266,11 +2212,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://ipfs.io/ipfs/QmPDd994RTF3MduJv77La2CXKEnK98depvEqWGEswbJgsf/animation.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/3865c195f3ab904e60670b516eb9a0ec.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-05-27T16:53:32.834583",
+					CreatedDate:                 MustParseTimestamp("2020-05-27T16:53:32.834583"),
 					DefaultToFiat:               false,
 					Description:                 "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
 					DevBuyerFeeBasisPoints:      0,
@@ -2291,16 +2237,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://storage.opensea.io/static/banners/rarible-banner4.png",
 					ChatURL:                 "",
-					CreatedDate:             "2020-01-01T13:22:57.777065",
+					CreatedDate:             MustParseTimestamp("2020-01-01T13:22:57.777065"),
 					DefaultToFiat:           false,
 					Description:             "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://rarible.com/",
@@ -2314,8 +2260,8 @@ var (
 					MediumUsername:              "rarible",
 					Name:                        "Rarible",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -2325,11 +2271,11 @@ var (
 					TwitterUsername:             "rariblecom",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "knightsof88",
 					},
 				},
@@ -2344,100 +2290,103 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Freedom On The Menu (Visionary)",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Freedom On The Menu (Visionary)",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink: "https://opensea.io/assets/0xd07dc4262bcdbf85190c01c996b4c06a461d2430/681954",
-				SellOrders: []interface{}{
-					map[string]interface{}{
-						"cancelled":          false,
-						"expiration_time":    0.000000,
-						"maker_protocol_fee": "0",
-						"sale_kind":          0.000000,
-						"static_extradata":   "0x",
-						"base_price":         "80000000000000000",
-						"extra":              "0",
-						"taker": map[string]interface{}{
-							"user":            1766.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-							"address":         "0x0000000000000000000000000000000000000000",
-							"config":          "",
-						},
-						"approved_on_chain":   false,
-						"replacement_pattern": "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-						"closing_extendable":  false,
-						"maker_referrer_fee":  "0",
-						"fee_method":          1.000000,
-						"side":                1.000000,
-						"finalized":           false,
-						"quantity":            "1",
-						"r":                   "0x5a473b96b01b84e04987426e05d3f380ffa231b41de4f0b349b8b8e8f457871b",
-						"listing_time":        1630004273.000000,
-						"order_hash":          "0x30665f7d6a09eca98999a2a4ef529f3847f2495ff2d6f1f8b62d44417a44c75d",
-						"exchange":            "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
-						"bounty_multiple":     "0.01",
-						"fee_recipient": map[string]interface{}{
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
-							"address":         "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
-							"config":          "verified",
-							"user":            3585.000000,
+				SellOrders: []Order{
+					Order{
+						ApprovedOnChain:   false,
+						BasePrice:         MustParsePrice("80000000000000000", 18),
+						BountyMultiple:    "0.01",
+						Calldata:          "0xf242432a000000000000000000000000a432cf92dcb8636cbf697f1c1c8076bb7f82f314000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a67e2000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+						Cancelled:         false,
+						ClosingExtendable: false,
+						CreatedDate:       MustParseTimestamp("2021-08-26T18:59:42.647990"),
+						CurrentBounty:     MustParsePrice("800000000000000", 18),
+						CurrentPrice:      MustParsePrice("80000000000000000", 18),
+						Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+						ExpirationTime:    UnixTimestamp(0),
+						Extra:             "0",
+						FeeMethod:         1,
+						FeeRecipient: Account{
+							Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+							Config:        "verified",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+							User: AccountUser{
+								ID: 3585,
+							},
 						},
-						"payment_token": "0x0000000000000000000000000000000000000000",
-						"maker": map[string]interface{}{
-							"user":            433742.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
-							"address":         "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
-							"config":          "",
+						Finalized:   false,
+						HowToCall:   0,
+						ListingTime: UnixTimestamp(1630004273),
+						Maker: Account{
+							Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+							User: AccountUser{
+								ID: 433742,
+							},
 						},
-						"current_bounty": "800000000000000",
-						"target":         "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
-						"static_target":  "0x0000000000000000000000000000000000000000",
-						"v":              27.000000,
-						"marked_invalid": false,
-						"prefixed_hash":  "0xc4aee32f1a2ca3ccbb2ee832acf479bcc952990e7b52c0df5092eff45ab4aaef",
-						"created_date":   "2021-08-26T18:59:42.647990",
-						"metadata": map[string]interface{}{
-							"schema": "ERC1155",
-							"asset": map[string]interface{}{
-								"id":       "681954",
-								"address":  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
-								"quantity": "1",
+						MakerProtocolFee: "0",
+						MakerReferrerFee: "0",
+						MakerRelayerFee:  "250",
+						MarkedInvalid:    false,
+						Metadata: OrderMetadata{
+							Asset: OrderMetadataAsset{
+								Address:  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+								ID:       MustParseTokenID("681954"),
+								Quantity: "1",
 							},
+							Schema: "ERC1155",
 						},
-						"maker_relayer_fee":  "250",
-						"taker_relayer_fee":  "0",
-						"calldata":           "0xf242432a000000000000000000000000a432cf92dcb8636cbf697f1c1c8076bb7f82f314000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a67e2000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
-						"salt":               "37807066599228839761082381987094645843543820864275911452860088185447967723074",
-						"closing_date":       nil,
-						"current_price":      "80000000000000000",
-						"taker_protocol_fee": "0",
-						"how_to_call":        0.000000,
-						"payment_token_contract": map[string]interface{}{
-							"decimals":  18.000000,
-							"eth_price": "1.000000000000000",
-							"usd_price": "2454.469999999999800000",
-							"id":        1.000000,
-							"symbol":    "ETH",
-							"address":   "0x0000000000000000000000000000000000000000",
-							"image_url": "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
-							"name":      "Ether",
+						OrderHash:    "0x30665f7d6a09eca98999a2a4ef529f3847f2495ff2d6f1f8b62d44417a44c75d",
+						PaymentToken: "0x0000000000000000000000000000000000000000",
+						PaymentTokenContract: PaymentToken{
+							Address:  "0x0000000000000000000000000000000000000000",
+							Decimals: intPtr(18),
+							EthPrice: "1.000000000000000",
+							ID:       1,
+							ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+							Name:     "Ether",
+							Symbol:   "ETH",
+							UsdPrice: "2454.469999999999800000",
 						},
-						"s": "0x7f481d13466b77c513fe23519abb3012d807764c4bafb73ca45a5fc5f9690b05",
+						PrefixedHash:       "0xc4aee32f1a2ca3ccbb2ee832acf479bcc952990e7b52c0df5092eff45ab4aaef",
+						Quantity:           "1",
+						R:                  "0x5a473b96b01b84e04987426e05d3f380ffa231b41de4f0b349b8b8e8f457871b",
+						ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+						S:                  "0x7f481d13466b77c513fe23519abb3012d807764c4bafb73ca45a5fc5f9690b05",
+						SaleKind:           0,
+						Salt:               "37807066599228839761082381987094645843543820864275911452860088185447967723074",
+						Side:               1,
+						StaticExtradata:    "0x",
+						StaticTarget:       "0x0000000000000000000000000000000000000000",
+						Taker: Account{
+							Address:       "0x0000000000000000000000000000000000000000",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+							User: AccountUser{
+								ID: 1766,
+							},
+						},
+						TakerProtocolFee: "0",
+						TakerRelayerFee:  "0",
+						Target:           "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+						V:                27,
 					},
 				},
-				TokenID:                 "681954",
+				TokenID:                 MustParseTokenID("681954"),
 				TokenMetadata:           "https://ipfs.io/ipfs/QmazchMpr9jeeZYcECtn9NM6cFCHYo8eQz4PTt5D9kN85m",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -2446,11 +2395,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://ipfs.io/ipfs/QmRJitUM4CvfaGugGqMDyeQZWbrcexmspDteToSekiydbF/animation.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/55d8513bee819d93764ede0487cb6f82.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-05-27T16:53:32.834583",
+					CreatedDate:                 MustParseTimestamp("2020-05-27T16:53:32.834583"),
 					DefaultToFiat:               false,
 					Description:                 "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
 					DevBuyerFeeBasisPoints:      0,
@@ -2471,16 +2420,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://storage.opensea.io/static/banners/rarible-banner4.png",
 					ChatURL:                 "",
-					CreatedDate:             "2020-01-01T13:22:57.777065",
+					CreatedDate:             MustParseTimestamp("2020-01-01T13:22:57.777065"),
 					DefaultToFiat:           false,
 					Description:             "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://rarible.com/",
@@ -2494,8 +2443,8 @@ var (
 					MediumUsername:              "rarible",
 					Name:                        "Rarible",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -2505,11 +2454,11 @@ var (
 					TwitterUsername:             "rariblecom",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "knightsof88",
 					},
 				},
@@ -2524,100 +2473,103 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "King Without A Crown",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "King Without A Crown",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink: "https://opensea.io/assets/0xd07dc4262bcdbf85190c01c996b4c06a461d2430/681811",
-				SellOrders: []interface{}{
-					map[string]interface{}{
-						"extra":        "0",
-						"closing_date": nil,
-						"listing_time": 1630313574.000000,
-						"metadata": map[string]interface{}{
-							"asset": map[string]interface{}{
-								"id":       "681811",
-								"address":  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
-								"quantity": "1",
+				SellOrders: []Order{
+					Order{
+						ApprovedOnChain:   false,
+						BasePrice:         MustParsePrice("1180000000000000000", 18),
+						BountyMultiple:    "0.01",
+						Calldata:          "0xf242432a000000000000000000000000a432cf92dcb8636cbf697f1c1c8076bb7f82f314000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a6753000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+						Cancelled:         false,
+						ClosingExtendable: false,
+						CreatedDate:       MustParseTimestamp("2021-08-30T08:54:41.608536"),
+						CurrentBounty:     MustParsePrice("11800000000000000", 18),
+						CurrentPrice:      MustParsePrice("1180000000000000000", 18),
+						Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+						ExpirationTime:    UnixTimestamp(0),
+						Extra:             "0",
+						FeeMethod:         1,
+						FeeRecipient: Account{
+							Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+							Config:        "verified",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+							User: AccountUser{
+								ID: 3585,
 							},
-							"schema": "ERC1155",
 						},
-						"maker_referrer_fee": "0",
-						"side":               1.000000,
-						"sale_kind":          0.000000,
-						"calldata":           "0xf242432a000000000000000000000000a432cf92dcb8636cbf697f1c1c8076bb7f82f314000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a6753000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
-						"cancelled":          false,
-						"taker": map[string]interface{}{
-							"user":            1766.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-							"address":         "0x0000000000000000000000000000000000000000",
-							"config":          "",
+						Finalized:   false,
+						HowToCall:   0,
+						ListingTime: UnixTimestamp(1630313574),
+						Maker: Account{
+							Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+							User: AccountUser{
+								ID: 433742,
+							},
 						},
-						"maker_relayer_fee":  "250",
-						"fee_method":         1.000000,
-						"how_to_call":        0.000000,
-						"static_extradata":   "0x",
-						"v":                  28.000000,
-						"closing_extendable": false,
-						"current_bounty":     "11800000000000000",
-						"fee_recipient": map[string]interface{}{
-							"user":            3585.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
-							"address":         "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
-							"config":          "verified",
+						MakerProtocolFee: "0",
+						MakerReferrerFee: "0",
+						MakerRelayerFee:  "250",
+						MarkedInvalid:    false,
+						Metadata: OrderMetadata{
+							Asset: OrderMetadataAsset{
+								Address:  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+								ID:       MustParseTokenID("681811"),
+								Quantity: "1",
+							},
+							Schema: "ERC1155",
 						},
-						"maker": map[string]interface{}{
-							"config":          "",
-							"user":            433742.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
-							"address":         "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+						OrderHash:    "0xf5116210ebe8ea11210024ce57de0fbaf4108ce8a7784029b3e9bfd75c8cc7f7",
+						PaymentToken: "0x0000000000000000000000000000000000000000",
+						PaymentTokenContract: PaymentToken{
+							Address:  "0x0000000000000000000000000000000000000000",
+							Decimals: intPtr(18),
+							EthPrice: "1.000000000000000",
+							ID:       1,
+							ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+							Name:     "Ether",
+							Symbol:   "ETH",
+							UsdPrice: "2454.469999999999800000",
 						},
-						"current_price":      "1180000000000000000",
-						"maker_protocol_fee": "0",
-						"target":             "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
-						"base_price":         "1180000000000000000",
-						"r":                  "0x43ca1485293c99fb83153b52d288155f7b3d855dcb94961db8b2d3cb18423dbe",
-						"taker_relayer_fee":  "0",
-						"payment_token_contract": map[string]interface{}{
-							"usd_price": "2454.469999999999800000",
-							"id":        1.000000,
-							"symbol":    "ETH",
-							"address":   "0x0000000000000000000000000000000000000000",
-							"image_url": "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
-							"name":      "Ether",
-							"decimals":  18.000000,
-							"eth_price": "1.000000000000000",
+						PrefixedHash:       "0x575f9e64740221bca5ca96aa67e39bd291b1aa3918de0136cd0a9600f406d3b5",
+						Quantity:           "1",
+						R:                  "0x43ca1485293c99fb83153b52d288155f7b3d855dcb94961db8b2d3cb18423dbe",
+						ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+						S:                  "0x55525caa95188ad6811266a648ee5203513893538082216ef39ff4b492adf855",
+						SaleKind:           0,
+						Salt:               "85879247897908819408467477064396564836743222690018654612674156226254589282222",
+						Side:               1,
+						StaticExtradata:    "0x",
+						StaticTarget:       "0x0000000000000000000000000000000000000000",
+						Taker: Account{
+							Address:       "0x0000000000000000000000000000000000000000",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+							User: AccountUser{
+								ID: 1766,
+							},
 						},
-						"salt":                "85879247897908819408467477064396564836743222690018654612674156226254589282222",
-						"finalized":           false,
-						"order_hash":          "0xf5116210ebe8ea11210024ce57de0fbaf4108ce8a7784029b3e9bfd75c8cc7f7",
-						"exchange":            "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
-						"bounty_multiple":     "0.01",
-						"replacement_pattern": "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-						"s":                   "0x55525caa95188ad6811266a648ee5203513893538082216ef39ff4b492adf855",
-						"approved_on_chain":   false,
-						"marked_invalid":      false,
-						"created_date":        "2021-08-30T08:54:41.608536",
-						"expiration_time":     0.000000,
-						"payment_token":       "0x0000000000000000000000000000000000000000",
-						"taker_protocol_fee":  "0",
-						"static_target":       "0x0000000000000000000000000000000000000000",
-						"quantity":            "1",
-						"prefixed_hash":       "0x575f9e64740221bca5ca96aa67e39bd291b1aa3918de0136cd0a9600f406d3b5",
-					},
-				},
-				TokenID:                 "681811",
+						TakerProtocolFee: "0",
+						TakerRelayerFee:  "0",
+						Target:           "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+						V:                28,
+					},
+				},
+				TokenID:                 MustParseTokenID("681811"),
 				TokenMetadata:           "https://ipfs.io/ipfs/QmRDjEFV9k11K2FqtDQEoPDQov8NJgUYihXr9o2iW6615a",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -2626,11 +2578,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -2651,16 +2603,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/n_cSgOznui9Ypq8y1SBcyZQGPPxB-1eRwxPOnciEM0QZfvUQv__8nnB380_0eX6G1Owk8bPWtQqS5ktDuR_FXebCq11IO2aN_cYrWiU=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-08-09T06:36:57.133366",
+					CreatedDate:             MustParseTimestamp("2021-08-09T06:36:57.133366"),
 					DefaultToFiat:           false,
 					Description:             "The Landing Party: Piracy Punks #0-#249\n\nLimited Edition: The OG Pirates - Holders of our Landing Party pirates can claim 1 additional free Piracy Punk at launch\n\nPiracy Punks Mint - 22nd November 2021\n\nJoin the Discord for updates https://discord.gg/piracypunks\n\n[www.piracypunks.com](https://www.piracypunks.com)",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/piracypunks",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://www.piracypunks.com",
@@ -2674,8 +2626,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Piracy Punks - The Landing Party",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xf3f3d029753fa3c29f9936c1e0576df79a3106ac",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -2685,11 +2637,11 @@ var (
 					TwitterUsername:             "piracypunks",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xf3f3d029753fa3c29f9936c1e0576df79a3106ac",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/15.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "PiracyPunksOfficial",
 					},
 				},
@@ -2704,24 +2656,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "PIRACY PUNK #249",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "PIRACY PUNK #249",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/110342802723420543531821824580435873410748652926890793610665319951784476672001",
-				SellOrders:              nil,
-				TokenID:                 "110342802723420543531821824580435873410748652926890793610665319951784476672001",
+				TokenID:                 MustParseTokenID("110342802723420543531821824580435873410748652926890793610665319951784476672001"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -2730,11 +2679,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xf9c813ceae0062743edd28b32714219a02c1dfff",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-08-21T06:45:12.536981",
+					CreatedDate:                 MustParseTimestamp("2021-08-21T06:45:12.536981"),
 					DefaultToFiat:               false,
 					Description:                 "Ethees are 250 adorable and unique NFTs that live in your wallet and the metaverse. The stats for each is randomly rolled then generated by hand.",
 					DevBuyerFeeBasisPoints:      0,
@@ -2755,16 +2704,16 @@ var (
 					TotalSupply:                 "0",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/vr1jn_eQFFs-7F1xAPPef09MgqjEjQUWndgQ2INAhDu-8Obs59F1cRZTVuRMUmWviqvFRBat3LW3Pw6Xraos5vr2-oELIqcoM7JS=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-08-22T04:53:19.541808",
+					CreatedDate:             MustParseTimestamp("2021-08-22T04:53:19.541808"),
 					DefaultToFiat:           false,
 					Description:             "Ethees are 250 adorable and unique NFTs that live in your wallet and the metaverse. The stats for each is randomly rolled then generated by hand.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "padded",
 					},
 					ExternalURL:                 "https://ethees.io",
@@ -2778,8 +2727,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Ethees Collection",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x0901d5481cd746708dc82d50f3e66188a950ffd8",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -2789,11 +2738,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xbfc9b3195d57b84c9c793d79ba7bd542a8606cc5",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
@@ -2808,24 +2757,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Umbreum #10",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Umbreum #10",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0xf9c813ceae0062743edd28b32714219a02c1dfff/17",
-				SellOrders:              nil,
-				TokenID:                 "17",
+				TokenID:                 MustParseTokenID("17"),
 				TokenMetadata:           "https://gateway.pinata.cloud/ipfs/QmPMRUsBFBbxRVMFKNhzBT9CEZLUeHy2HMFE9FEUBAUqxa/17",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -2834,11 +2780,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -2859,16 +2805,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/FskpOuSNd3jK_9j5BvTbhIGDpjkQRsb8VdsypEkgrcT-M8YYc9G79qpKtDU__s7gWCEgxU_xuYVZhJd5AqysAR7LELdlq0t4svlsgg=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-03-09T14:30:04.770157",
+					CreatedDate:             MustParseTimestamp("2021-03-09T14:30:04.770157"),
 					DefaultToFiat:           false,
 					Description:             "This project is a tribute to Nipsey Hussle by artist + technologist Israel Wilson. It is comprised of 60 pieces of art. For each piece sold one engineer from South Central Los Angeles will be recruited, educated, and supported. 60% of the project's primary and the secondary sales will be distributed in the following manner: 20% for Emani Ashgedom, 20% to Kross Ashgedom, and 20% into a DAO formed for the purpose of perpetually funding the yearly training and retraining of citizens from low opportunity environments starting with the Crenshaw District of Los Angeles. ",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://60nipseys.com",
@@ -2882,8 +2828,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "60 Nipseys",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xfa1bc1d562d3e36f2e611db5445f135b051b6272",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -2893,11 +2839,11 @@ var (
 					TwitterUsername:             "israelswilson",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xe631b7c5cd957bba32a732d1e833eed62bba9d2d",
 					Config:        "affiliate",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "IsraelsWilson",
 					},
 				},
@@ -2912,24 +2858,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "60 Nipseys #3: East African King",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "60 Nipseys #3: East African King",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/104119799032666879918840094235775138911520446080664845329478292836557493633025",
-				SellOrders:              nil,
-				TokenID:                 "104119799032666879918840094235775138911520446080664845329478292836557493633025",
+				TokenID:                 MustParseTokenID("104119799032666879918840094235775138911520446080664845329478292836557493633025"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -2938,11 +2881,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x77a251ac8a70cf15dd2e80329fa8c464101087b0",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-08-13T22:56:25.369168",
+					CreatedDate:                 MustParseTimestamp("2021-08-13T22:56:25.369168"),
 					DefaultToFiat:               false,
 					Description:                 "Everyone always asks Wen Slumbo. No one ever asks HOW Slumbo? \nPresenting: Slumboginis - a vehicular collab between Boss Logic and Slumdoge. \nFind the super rare Boss Logic creations among the madness of the Slumbogini fleet.",
 					DevBuyerFeeBasisPoints:      0,
@@ -2963,16 +2906,16 @@ var (
 					TotalSupply:                 "10000",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/xJkmEsytbU95J8Kw_INjENAaZiSNqOQhBhbFG01FVCoamoEhgGGwNMIhekzJstCmOYPa0VkL6fK0ixUw3aQ02CLuske53xp_-Muoww=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-08-13T22:56:25.920507",
+					CreatedDate:             MustParseTimestamp("2021-08-13T22:56:25.920507"),
 					DefaultToFiat:           false,
 					Description:             "Everyone always asks Wen Slumbo. No one ever asks HOW Slumbo? \nPresenting: Slumboginis - a vehicular collab between Boss Logic and Slumdoge. \nFind the super rare Boss Logic creations among the madness of the Slumbogini fleet.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/xYPCmHSNRH",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://slumdoges.com/",
@@ -2986,8 +2929,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Slumboginis",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x4828bbc480bd3e2459a6bd955ebf5ee5b98c7717",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -2997,11 +2940,11 @@ var (
 					TwitterUsername:             "slumdoges",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xfcc450dcefade7bba0f78905215d044adc68cd0b",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/19.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
@@ -3016,24 +2959,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Slumbogini #5755",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Slumbogini #5755",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x77a251ac8a70cf15dd2e80329fa8c464101087b0/5755",
-				SellOrders:              nil,
-				TokenID:                 "5755",
+				TokenID:                 MustParseTokenID("5755"),
 				TokenMetadata:           "https://ipfs.io/ipfs/QmWLguevyWko1bwNEoHDnFHNYq69tyEgdiKXmJGDyHbrFw/5755",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3042,11 +2982,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -3067,16 +3007,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/ZGmP4GVzmxgFaBKZmSiutLfp-E2Mr01NN_O2Xk0rCKDgQVtS0xh-cTueMtEhOfsIcylGUk5IwEEY_Xq0uJY7fUKGwM2oV17mprZL0Q=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-08-08T07:07:51.593071",
+					CreatedDate:             MustParseTimestamp("2021-08-08T07:07:51.593071"),
 					DefaultToFiat:           false,
 					Description:             "Greyscale Punks is an NFT collection of Punks with a unique twist! Every day, at no specific time, new Greyscale Punks will be created, (with a supply cap of 2,500) starting at a price of just .02 ETH. Every week, however, the starting price of each Greyscale Punk created will increase by .005 ETH, raising the floor to protect your previous investments and add value to the project as it grows. This will cap at .05 ETH, and when that happens the base price of a new Greyscale Punk will no longer rise. Each Greyscale Punk created is a 1 of 1, meaning there will never be any duplicates created! This product is a parody of CryptoPunks and is in no way affiliated with Larva Labs.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/Jsm7bDsE",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://greyscalepunks.com",
@@ -3090,8 +3030,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Greyscale Punks",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xa6d069ff8467600f444eec699a8ec01ce154dd15",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -3101,11 +3041,11 @@ var (
 					TwitterUsername:             "greyscalepunks",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xa6d069ff8467600f444eec699a8ec01ce154dd15",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "GreyscalePunks",
 					},
 				},
@@ -3120,24 +3060,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "#6095",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "#6095",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/75452168626392686147620223871404081383733532272954961186388256762304428769281",
-				SellOrders:              nil,
-				TokenID:                 "75452168626392686147620223871404081383733532272954961186388256762304428769281",
+				TokenID:                 MustParseTokenID("75452168626392686147620223871404081383733532272954961186388256762304428769281"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3146,11 +3083,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -3171,16 +3108,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/pl4P8cYmKY9OybIKgOCg9jRHgXLql-EfTVVfkLTWFu56fFQKXvm-2gJ9jp6V8BtTg6lsbzX9PJqJ65lbZamC715LmK-oN6z_52y-6Q=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-07-31T01:46:44.777143",
+					CreatedDate:             MustParseTimestamp("2021-07-31T01:46:44.777143"),
 					DefaultToFiat:           false,
 					Description:             "An ever growing collection (505 NFTs) of pixel art animals from that famous voyage we all know and love! Rarity every 100 animals in the form of an exotic creature. 20% of all proceeds will be donated to the World Wildlife Fund to help our furry little friends..\nThe other 80% is going towards building an animal sanctuary! Collect, invest, help!\n",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://digiark.myshopify.com",
@@ -3194,8 +3131,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "DigiArk",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xb702ddfe2949d129d4e883661faf61c54fb24634",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -3205,11 +3142,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x4dac7aeb838aa102bb7a0f52c83283b4f60d6998",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/5.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "0SK3RM1K3",
 					},
 				},
@@ -3224,24 +3161,22 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "35132835398548108088601526195747455005626037312340391373080220048737947353089",
+						TokenID:  MustParseTokenID("35132835398548108088601526195747455005626037312340391373080220048737947353089"),
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Goat (Male)",
-				NumSales:    1,
-				Owner: AssetOwner{
+				Name:     "Goat (Male)",
+				NumSales: 1,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/35132835398548108088601526195747455005626037312340391373080220048737947353089",
-				SellOrders:              nil,
-				TokenID:                 "35132835398548108088601526195747455005626037312340391373080220048737947353089",
+				TokenID:                 MustParseTokenID("35132835398548108088601526195747455005626037312340391373080220048737947353089"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3250,11 +3185,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -3275,16 +3210,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/pl4P8cYmKY9OybIKgOCg9jRHgXLql-EfTVVfkLTWFu56fFQKXvm-2gJ9jp6V8BtTg6lsbzX9PJqJ65lbZamC715LmK-oN6z_52y-6Q=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-07-31T01:46:44.777143",
+					CreatedDate:             MustParseTimestamp("2021-07-31T01:46:44.777143"),
 					DefaultToFiat:           false,
 					Description:             "An ever growing collection (505 NFTs) of pixel art animals from that famous voyage we all know and love! Rarity every 100 animals in the form of an exotic creature. 20% of all proceeds will be donated to the World Wildlife Fund to help our furry little friends..\nThe other 80% is going towards building an animal sanctuary! Collect, invest, help!\n",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://digiark.myshopify.com",
@@ -3298,8 +3233,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "DigiArk",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xb702ddfe2949d129d4e883661faf61c54fb24634",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -3309,11 +3244,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x4dac7aeb838aa102bb7a0f52c83283b4f60d6998",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/5.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "0SK3RM1K3",
 					},
 				},
@@ -3328,24 +3263,22 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "35132835398548108088601526195747455005626037312340391373080220047638435725313",
+						TokenID:  MustParseTokenID("35132835398548108088601526195747455005626037312340391373080220047638435725313"),
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Goat (Female)",
-				NumSales:    1,
-				Owner: AssetOwner{
+				Name:     "Goat (Female)",
+				NumSales: 1,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/35132835398548108088601526195747455005626037312340391373080220047638435725313",
-				SellOrders:              nil,
-				TokenID:                 "35132835398548108088601526195747455005626037312340391373080220047638435725313",
+				TokenID:                 MustParseTokenID("35132835398548108088601526195747455005626037312340391373080220047638435725313"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3354,11 +3287,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -3379,16 +3312,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/L4r9lnloA6a5qInMj_kgVpkQJkdz9u6mddEKQPtFpQbxLCmnsLOxEM_PPMafd9dSWYG-aELL7Mf7MQfFw1xGIJLx7owOAP4ItMOlfw=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-07-21T03:23:49.697943",
+					CreatedDate:             MustParseTimestamp("2021-07-21T03:23:49.697943"),
 					DefaultToFiat:           false,
 					Description:             "This comic book cover Shero is Baddie Brown, she is all in on crypto. Her mission; to shut down the Banksters and be a crusader for a decentralized world.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "https://www.instagram.com/shortydunkin/",
@@ -3402,8 +3335,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Baddie Brown",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x3c712cf18a6fc5f469c36ce1a9fbc4dec14923d3",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -3413,11 +3346,11 @@ var (
 					TwitterUsername:             "dunkinshorty",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x3c712cf18a6fc5f469c36ce1a9fbc4dec14923d3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/27.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "ShortyDunkin",
 					},
 				},
@@ -3432,100 +3365,104 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "27338734822081423402480608095885198417758868506159634711418379076407945854988",
+						TokenID:  MustParseTokenID("27338734822081423402480608095885198417758868506159634711418379076407945854988"),
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Baddie Brown 12/12",
-				NumSales:    3,
-				Owner: AssetOwner{
+				Name:     "Baddie Brown 12/12",
+				NumSales: 3,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink: "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/27338734822081423402480608095885198417758868506159634711418379076407945854988",
-				SellOrders: []interface{}{
-					map[string]interface{}{
-						"how_to_call":        0.000000,
-						"salt":               "54874345765750969106264759260276084074093417495581594754674771515548580217483",
-						"finalized":          false,
-						"closing_extendable": false,
-						"side":               1.000000,
-						"cancelled":          false,
-						"closing_date":       nil,
-						"maker_protocol_fee": "0",
-						"maker_referrer_fee": "0",
-						"bounty_multiple":    "0.01",
-						"calldata":           "0xf242432a0000000000000000000000003c712cf18a6fc5f469c36ce1a9fbc4dec14923d300000000000000000000000000000000000000000000000000000000000000003c712cf18a6fc5f469c36ce1a9fbc4dec14923d300000000000023000000000c000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
-						"payment_token":      "0x0000000000000000000000000000000000000000",
-						"payment_token_contract": map[string]interface{}{
-							"eth_price": "1.000000000000000",
-							"usd_price": "2454.469999999999800000",
-							"id":        1.000000,
-							"symbol":    "ETH",
-							"address":   "0x0000000000000000000000000000000000000000",
-							"image_url": "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
-							"name":      "Ether",
-							"decimals":  18.000000,
+				SellOrders: []Order{
+					Order{
+						ApprovedOnChain:   false,
+						BasePrice:         MustParsePrice("5000000000000000", 18),
+						BountyMultiple:    "0.01",
+						Calldata:          "0xf242432a0000000000000000000000003c712cf18a6fc5f469c36ce1a9fbc4dec14923d300000000000000000000000000000000000000000000000000000000000000003c712cf18a6fc5f469c36ce1a9fbc4dec14923d300000000000023000000000c000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+						Cancelled:         false,
+						ClosingExtendable: false,
+						CreatedDate:       MustParseTimestamp("2021-08-21T05:41:39.245776"),
+						CurrentBounty:     MustParsePrice("50000000000000", 18),
+						CurrentPrice:      MustParsePrice("5000000000000000", 18),
+						Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+						ExpirationTime:    UnixTimestamp(0),
+						Extra:             "0",
+						FeeMethod:         1,
+						FeeRecipient: Account{
+							Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+							Config:        "verified",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+							User: AccountUser{
+								ID: 3585,
+							},
 						},
-						"s":                  "0x2cde06231952c57bb36075125f159c0ef5fbc9685a72ad6e44f228be92bb1a7e",
-						"listing_time":       1629524385.000000,
-						"current_bounty":     "50000000000000",
-						"current_price":      "5000000000000000",
-						"taker_protocol_fee": "0",
-						"fee_recipient": map[string]interface{}{
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
-							"address":         "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
-							"config":          "verified",
-							"user":            3585.000000,
+						Finalized:   false,
+						HowToCall:   0,
+						ListingTime: UnixTimestamp(1629524385),
+						Maker: Account{
+							Address:       "0x3c712cf18a6fc5f469c36ce1a9fbc4dec14923d3",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/27.png",
+							User: AccountUser{
+								ID: 585736,
+							},
 						},
-						"target":           "0x495f947276749ce646f68ac8c248420045cb7b5e",
-						"static_extradata": "0x",
-						"created_date":     "2021-08-21T05:41:39.245776",
-						"metadata": map[string]interface{}{
-							"schema": "ERC1155",
-							"asset": map[string]interface{}{
-								"id":       "27338734822081423402480608095885198417758868506159634711418379076407945854988",
-								"address":  "0x495f947276749ce646f68ac8c248420045cb7b5e",
-								"quantity": "1",
+						MakerProtocolFee: "0",
+						MakerReferrerFee: "0",
+						MakerRelayerFee:  "1250",
+						MarkedInvalid:    false,
+						Metadata: OrderMetadata{
+							Asset: OrderMetadataAsset{
+								Address:  "0x495f947276749ce646f68ac8c248420045cb7b5e",
+								ID:       MustParseTokenID("27338734822081423402480608095885198417758868506159634711418379076407945854988"),
+								Quantity: "1",
 							},
+							Schema: "ERC1155",
 						},
-						"replacement_pattern": "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-						"prefixed_hash":       "0xe63bd01c1323ba3f7de89a7ac7e7db1c6dfb8c055e8b376d821ff35652fab4c0",
-						"expiration_time":     0.000000,
-						"exchange":            "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
-						"static_target":       "0x0000000000000000000000000000000000000000",
-						"base_price":          "5000000000000000",
-						"extra":               "0",
-						"quantity":            "1",
-						"v":                   27.000000,
-						"approved_on_chain":   false,
-						"order_hash":          "0xc840b911dbdb8cd14ba9a18af880bab0bdf09989823a8dc91b52111d40d7e4e4",
-						"fee_method":          1.000000,
-						"maker_relayer_fee":   "1250",
-						"taker_relayer_fee":   "0",
-						"sale_kind":           0.000000,
-						"r":                   "0xb2013d2c2d60a78d8ff01aea1790c11694744423f74a3a73565ca2646d12ee3d",
-						"marked_invalid":      false,
-						"maker": map[string]interface{}{
-							"user":            585736.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/27.png",
-							"address":         "0x3c712cf18a6fc5f469c36ce1a9fbc4dec14923d3",
-							"config":          "",
+						OrderHash:    "0xc840b911dbdb8cd14ba9a18af880bab0bdf09989823a8dc91b52111d40d7e4e4",
+						PaymentToken: "0x0000000000000000000000000000000000000000",
+						PaymentTokenContract: PaymentToken{
+							Address:  "0x0000000000000000000000000000000000000000",
+							Decimals: intPtr(18),
+							EthPrice: "1.000000000000000",
+							ID:       1,
+							ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+							Name:     "Ether",
+							Symbol:   "ETH",
+							UsdPrice: "2454.469999999999800000",
 						},
-						"taker": map[string]interface{}{
-							"user":            1766.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-							"address":         "0x0000000000000000000000000000000000000000",
-							"config":          "",
+						PrefixedHash:       "0xe63bd01c1323ba3f7de89a7ac7e7db1c6dfb8c055e8b376d821ff35652fab4c0",
+						Quantity:           "1",
+						R:                  "0xb2013d2c2d60a78d8ff01aea1790c11694744423f74a3a73565ca2646d12ee3d",
+						ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+						S:                  "0x2cde06231952c57bb36075125f159c0ef5fbc9685a72ad6e44f228be92bb1a7e",
+						SaleKind:           0,
+						Salt:               "54874345765750969106264759260276084074093417495581594754674771515548580217483",
+						Side:               1,
+						StaticExtradata:    "0x",
+						StaticTarget:       "0x0000000000000000000000000000000000000000",
+						Taker: Account{
+							Address:       "0x0000000000000000000000000000000000000000",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+							User: AccountUser{
+								ID: 1766,
+							},
 						},
+						TakerProtocolFee: "0",
+						TakerRelayerFee:  "0",
+						Target:           "0x495f947276749ce646f68ac8c248420045cb7b5e",
+						V:                27,
 					},
 				},
-				TokenID:                 "27338734822081423402480608095885198417758868506159634711418379076407945854988",
+				TokenID:                 MustParseTokenID("27338734822081423402480608095885198417758868506159634711418379076407945854988"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3534,11 +3471,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/315f3a7555ddf01280e451fb76ba628c.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/315f3a7555ddf01280e451fb76ba628c.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -3559,16 +3496,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "",
 					ChatURL:                 "",
-					CreatedDate:             "2021-07-19T13:02:53.198100",
+					CreatedDate:             MustParseTimestamp("2021-07-19T13:02:53.198100"),
 					DefaultToFiat:           false,
 					Description:             "a collection of blue Shiba Inu Coins to celebrate me being verified on Variable",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "",
@@ -3582,8 +3519,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Blue Shiba Inu Coin",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xc975f16afeda3e4f517235b0e90cf6bc48f4b8c0",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -3593,11 +3530,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xe53c610cc6f0bb220877fade0ef1e1c8c2b64156",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "IAmKilenemTheCollector",
 					},
 				},
@@ -3612,24 +3549,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Blue Shiba Inu Coin on Dark Orange",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Blue Shiba Inu Coin on Dark Orange",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/103686322963320569422618271229425488554637941382049711619264402753901066977281",
-				SellOrders:              nil,
-				TokenID:                 "103686322963320569422618271229425488554637941382049711619264402753901066977281",
+				TokenID:                 MustParseTokenID("103686322963320569422618271229425488554637941382049711619264402753901066977281"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3638,11 +3572,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -3663,16 +3597,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/JaBCCimcAXcZ4NkdLPG3K-PDGIV8ZQOsTxIjtuPpJeqwxY-NhDgF186HF70a7aN3h1-16cV7hri9lMACskhlLrtUTFDrOHX-IMNL9g=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-06-16T01:48:19.445698",
+					CreatedDate:             MustParseTimestamp("2021-06-16T01:48:19.445698"),
 					DefaultToFiat:           false,
 					Description:             "A Well Known Logo, Mashed up with a Few Other Logos. (I am not Affiliated with any of these brands, and this art is Parody for the sake of a Parody.)",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "http://remondinifineart.com",
@@ -3686,8 +3620,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "As Seen On TV",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x7293ff1d149a9b96e6a7494fab77e6cc169b9156",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -3697,11 +3631,11 @@ var (
 					TwitterUsername:             "remondiniart",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x169e4f31eafc354226d73e1472e84d30a9c15210",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/11.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Remondini",
 					},
 				},
@@ -3716,24 +3650,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "As Seen On - NYY 3",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "As Seen On - NYY 3",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/10230591088812457033718901168189344744337200517224114374701087263751592214529",
-				SellOrders:              nil,
-				TokenID:                 "10230591088812457033718901168189344744337200517224114374701087263751592214529",
+				TokenID:                 MustParseTokenID("10230591088812457033718901168189344744337200517224114374701087263751592214529"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3742,11 +3673,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/78ed25d244f29b13fe925bb7daefffcc.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/78ed25d244f29b13fe925bb7daefffcc.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -3767,16 +3698,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "",
 					ChatURL:                 "",
-					CreatedDate:             "2021-07-14T17:45:02.342801",
+					CreatedDate:             MustParseTimestamp("2021-07-14T17:45:02.342801"),
 					DefaultToFiat:           false,
 					Description:             "Green Shiba Inu Coin Collection, after purchace you will recieve the Original Nft and a 2nd custom Engraved NFT coin and The Files Required to 3D Print the coins",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -3790,8 +3721,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "GREEN SHIBA COIN",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -3801,11 +3732,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xc975f16afeda3e4f517235b0e90cf6bc48f4b8c0",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/11.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "IAmKilenem",
 					},
 				},
@@ -3820,24 +3751,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Green Shiba Coin on Yellow",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Green Shiba Coin on Yellow",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/91123269877278640483674357660094282247479139148659700340427715808130129461249",
-				SellOrders:              nil,
-				TokenID:                 "91123269877278640483674357660094282247479139148659700340427715808130129461249",
+				TokenID:                 MustParseTokenID("91123269877278640483674357660094282247479139148659700340427715808130129461249"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3846,11 +3774,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://ipfs.io/ipfs/QmeZGL52va4ZbRPjMuqVTjGAAJ4hPEtYWfnboLS3aNhjBx/animation.mpga",
 				AnimationURL:         "https://storage.opensea.io/files/747ec6659ad0a6a80e47046210f959ec.mp3",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x60f80121c31a0d46b5279700f9df786054aa5ee5",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-05-27T17:06:50.488471",
+					CreatedDate:                 MustParseTimestamp("2020-05-27T17:06:50.488471"),
 					DefaultToFiat:               false,
 					Description:                 "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
 					DevBuyerFeeBasisPoints:      0,
@@ -3871,16 +3799,16 @@ var (
 					TotalSupply:                 "1",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://storage.opensea.io/static/banners/rarible-banner4.png",
 					ChatURL:                 "",
-					CreatedDate:             "2020-01-01T13:22:57.777065",
+					CreatedDate:             MustParseTimestamp("2020-01-01T13:22:57.777065"),
 					DefaultToFiat:           false,
 					Description:             "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://rarible.com/",
@@ -3894,8 +3822,8 @@ var (
 					MediumUsername:              "rarible",
 					Name:                        "Rarible",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -3905,11 +3833,11 @@ var (
 					TwitterUsername:             "rariblecom",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xc975f16afeda3e4f517235b0e90cf6bc48f4b8c0",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/11.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "IAmKilenem",
 					},
 				},
@@ -3924,24 +3852,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Yeet 2 Full Package Unlockable",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Yeet 2 Full Package Unlockable",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x60f80121c31a0d46b5279700f9df786054aa5ee5/1124338",
-				SellOrders:              nil,
-				TokenID:                 "1124338",
+				TokenID:                 MustParseTokenID("1124338"),
 				TokenMetadata:           "https://ipfs.io/ipfs/QmV3UaWfj1Es7VqjMyZeePF3fkXatH1oj57vGcmCs3yWCp",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -3950,11 +3875,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -3975,16 +3900,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "",
 					ChatURL:                 "",
-					CreatedDate:             "2021-07-06T22:23:22.177522",
+					CreatedDate:             MustParseTimestamp("2021-07-06T22:23:22.177522"),
 					DefaultToFiat:           false,
 					Description:             "An unreleased 5 photo collection of DMX recording at Quad Studios in New York\n",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -3998,8 +3923,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "DMX Studio Sessions",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x5bdf64aef6e7af4722910580d69619e99ecd1f08",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -4009,11 +3934,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x5bdf64aef6e7af4722910580d69619e99ecd1f08",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/11.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "DMXstudiosessions",
 					},
 				},
@@ -4028,24 +3953,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "DMX 56-1 (Edition 1 of 3)",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "DMX 56-1 (Edition 1 of 3)",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/41555171008190353487389545654083909186567624433000785228787368247099446525953",
-				SellOrders:              nil,
-				TokenID:                 "41555171008190353487389545654083909186567624433000785228787368247099446525953",
+				TokenID:                 MustParseTokenID("41555171008190353487389545654083909186567624433000785228787368247099446525953"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -4054,11 +3976,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://ipfs.io/ipfs/QmaFPkRbfRqCWoTKhM63oxFksngW4daQDgq3Gxhq9qA93Y/animation.mpga",
 				AnimationURL:         "https://storage.opensea.io/files/747ec6659ad0a6a80e47046210f959ec.mp3",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-05-27T16:53:32.834583",
+					CreatedDate:                 MustParseTimestamp("2020-05-27T16:53:32.834583"),
 					DefaultToFiat:               false,
 					Description:                 "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
 					DevBuyerFeeBasisPoints:      0,
@@ -4079,16 +4001,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://storage.opensea.io/static/banners/rarible-banner4.png",
 					ChatURL:                 "",
-					CreatedDate:             "2020-01-01T13:22:57.777065",
+					CreatedDate:             MustParseTimestamp("2020-01-01T13:22:57.777065"),
 					DefaultToFiat:           false,
 					Description:             "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://rarible.com/",
@@ -4102,8 +4024,8 @@ var (
 					MediumUsername:              "rarible",
 					Name:                        "Rarible",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -4113,11 +4035,11 @@ var (
 					TwitterUsername:             "rariblecom",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xc975f16afeda3e4f517235b0e90cf6bc48f4b8c0",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/11.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "IAmKilenem",
 					},
 				},
@@ -4132,24 +4054,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Yeet 2 Ep Unlockable",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Yeet 2 Ep Unlockable",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0xd07dc4262bcdbf85190c01c996b4c06a461d2430/634352",
-				SellOrders:              nil,
-				TokenID:                 "634352",
+				TokenID:                 MustParseTokenID("634352"),
 				TokenMetadata:           "https://ipfs.io/ipfs/Qmdc2Cxv6YaY1EXnQAm3weg6nyye4Y1595GTsN8Z8S65Jf",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -4158,11 +4077,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/b694a7d82294c59eccbcbae3b2513d72.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/b694a7d82294c59eccbcbae3b2513d72.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -4183,16 +4102,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/YXhy29DVkhSXnhxmnrK6I_m3Wah7ARlgLygRDyqlv-o3Gz167zAkGFqULKfaAt9IlntlS2FIHU-N96uBXlQ38D79iNeU334zF8oeV0w=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-05-28T14:12:22.610940",
+					CreatedDate:             MustParseTimestamp("2021-05-28T14:12:22.610940"),
 					DefaultToFiat:           false,
 					Description:             "Join along the Galaxy Beat Squad. As they travel through space and make some bangers. \n\nReceive a copy of the beat as an unlockable content. ",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -4206,8 +4125,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Galaxy Beat Squad",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x49bbded8cf227454c4b4dd87b4723af59e0c1e59",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -4217,11 +4136,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x49bbded8cf227454c4b4dd87b4723af59e0c1e59",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/22.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "iAmONHEL",
 					},
 				},
@@ -4236,24 +4155,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "S1 - 056 - Pepe",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "S1 - 056 - Pepe",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/33350776380543289931625223678819456422611438718865436933080962114360042323969",
-				SellOrders:              nil,
-				TokenID:                 "33350776380543289931625223678819456422611438718865436933080962114360042323969",
+				TokenID:                 MustParseTokenID("33350776380543289931625223678819456422611438718865436933080962114360042323969"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -4262,11 +4178,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -4287,16 +4203,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/0zZD36dWAmyc8OPABBfNmQiPKFQHTCigRY_bcEWXnxMGbil3TZsda6wTNI1OVlBQay8mf5Qld2SgQ03oe6d0Hx4oy8PzCQklXqEQgos=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-05-08T18:36:43.465799",
+					CreatedDate:             MustParseTimestamp("2021-05-08T18:36:43.465799"),
 					DefaultToFiat:           false,
 					Description:             "All kidsbits are handmade!\n\nOur voxel Kidsbits fully created and rendered in magicavoxel, and you will get 3d model of your kid as well. These are not just characters cut from pictures, they have their own characteristics, style, and colors.  They are unique and single edition 1/1 NFTs. Only 1500 unique Kidsbits will be created.\n\nThere are unique and Rares in the collection!\n\n- Each kid includes a 3D model (.obj), an avatar (900x1200px) and a .vox file\n- If you have any problems, feel free to dm me anytime\n- The Metaverse, cryptovoxels, The Sandbox friendly \n\n(1-180 kids have small owner pack, available for download, please dm me on Twitter to get the full pack with animations, and rigged T-pose)\n\nAffordable price: NO FOMO",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "500",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 500,
 					DiscordURL:              "https://discord.gg/MYz4KkPFqu",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "https://thekidsbits.com",
@@ -4310,8 +4226,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "The kidsbits",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0xa616e0b15ad8c69c1e25f2d5e032cec49a40919c",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -4321,11 +4237,11 @@ var (
 					TwitterUsername:             "kidsbits_nft",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xa616e0b15ad8c69c1e25f2d5e032cec49a40919c",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/30.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "TheKidsbits",
 					},
 				},
@@ -4340,24 +4256,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "The kidsbits #00884",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "The kidsbits #00884",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/75124354272906868747139630718531087883927427214061699967493981778470982647809",
-				SellOrders:              nil,
-				TokenID:                 "75124354272906868747139630718531087883927427214061699967493981778470982647809",
+				TokenID:                 MustParseTokenID("75124354272906868747139630718531087883927427214061699967493981778470982647809"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -4366,11 +4279,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -4391,16 +4304,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/F7oF9QOLBJPQsPKMw_e9HfK7uerA4NtDs_Rv5MMTpPD4ULFnxRxwnyb6XFSgPr5yTzsidZjKUoZ-x25RtR1jzSCxNSjepggUTZx0pQ=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-06-26T17:01:36.463393",
+					CreatedDate:             MustParseTimestamp("2021-06-26T17:01:36.463393"),
 					DefaultToFiat:           false,
 					Description:             "Punks for the people!",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "900",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 900,
 					DiscordURL:              "https://discord.gg/WxQCCyeUuC",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "cover",
 					},
 					ExternalURL:                 "",
@@ -4414,8 +4327,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Minimum Wage Punks",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x630a035be662260db9fe33cd58afd71f7e8f4fa3",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -4425,11 +4338,11 @@ var (
 					TwitterUsername:             "minimumwagepunk",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x630a035be662260db9fe33cd58afd71f7e8f4fa3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/9.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Hunter_420",
 					},
 				},
@@ -4444,100 +4357,105 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "44796663663247546469885569892166981646365841902102129104966958429622287466596",
+						TokenID:  MustParseTokenID("44796663663247546469885569892166981646365841902102129104966958429622287466596"),
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "KFC Punk",
-				NumSales:    51,
-				Owner: AssetOwner{
+				Name:     "KFC Punk",
+				NumSales: 51,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink: "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/44796663663247546469885569892166981646365841902102129104966958429622287466596",
-				SellOrders: []interface{}{
-					map[string]interface{}{
-						"fee_recipient": map[string]interface{}{
-							"address":         "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
-							"config":          "verified",
-							"user":            3585.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+				SellOrders: []Order{
+					Order{
+						ApprovedOnChain:   false,
+						BasePrice:         MustParsePrice("20000000000000000", 18),
+						BountyMultiple:    "0.01",
+						Calldata:          "0xf242432a000000000000000000000000630a035be662260db9fe33cd58afd71f7e8f4fa30000000000000000000000000000000000000000000000000000000000000000630a035be662260db9fe33cd58afd71f7e8f4fa3000000000000450000000064000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+						Cancelled:         false,
+						ClosingDate:       MustParseTimestamp("2022-02-14T22:10:45"),
+						ClosingExtendable: false,
+						CreatedDate:       MustParseTimestamp("2022-01-14T22:11:00.913755"),
+						CurrentBounty:     MustParsePrice("200000000000000", 18),
+						CurrentPrice:      MustParsePrice("20000000000000000.00000000000", 18),
+						Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+						ExpirationTime:    UnixTimestamp(1644876645),
+						Extra:             "0",
+						FeeMethod:         1,
+						FeeRecipient: Account{
+							Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+							Config:        "verified",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+							User: AccountUser{
+								ID: 3585,
+							},
 						},
-						"fee_method":        1.000000,
-						"approved_on_chain": false,
-						"listing_time":      1642198153.000000,
-						"taker": map[string]interface{}{
-							"user":            1766.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-							"address":         "0x0000000000000000000000000000000000000000",
-							"config":          "",
+						Finalized:   false,
+						HowToCall:   0,
+						ListingTime: UnixTimestamp(1642198153),
+						Maker: Account{
+							Address:       "0x630a035be662260db9fe33cd58afd71f7e8f4fa3",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/9.png",
+							User: AccountUser{
+								ID: 869843,
+							},
 						},
-						"exchange":        "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
-						"current_bounty":  "200000000000000",
-						"sale_kind":       0.000000,
-						"how_to_call":     0.000000,
-						"static_target":   "0x0000000000000000000000000000000000000000",
-						"created_date":    "2022-01-14T22:11:00.913755",
-						"expiration_time": 1644876645.000000,
-						"metadata": map[string]interface{}{
-							"asset": map[string]interface{}{
-								"address":  "0x495f947276749ce646f68ac8c248420045cb7b5e",
-								"quantity": "1",
-								"id":       "44796663663247546469885569892166981646365841902102129104966958429622287466596",
+						MakerProtocolFee: "0",
+						MakerReferrerFee: "0",
+						MakerRelayerFee:  "1150",
+						MarkedInvalid:    false,
+						Metadata: OrderMetadata{
+							Asset: OrderMetadataAsset{
+								Address:  "0x495f947276749ce646f68ac8c248420045cb7b5e",
+								ID:       MustParseTokenID("44796663663247546469885569892166981646365841902102129104966958429622287466596"),
+								Quantity: "1",
 							},
-							"schema": "ERC1155",
+							Schema: "ERC1155",
 						},
-						"calldata":            "0xf242432a000000000000000000000000630a035be662260db9fe33cd58afd71f7e8f4fa30000000000000000000000000000000000000000000000000000000000000000630a035be662260db9fe33cd58afd71f7e8f4fa3000000000000450000000064000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
-						"closing_date":        "2022-02-14T22:10:45",
-						"order_hash":          "0xce20127ae8ad845f825c28f045eb4c23cc23953bc4e3f9912bad938cbbb81bdc",
-						"replacement_pattern": "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-						"r":                   "0xb09c281aceaa7e3070038f65a379af01c3fabc103a33071a41cb663806b71c9f",
-						"maker": map[string]interface{}{
-							"user":            869843.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/9.png",
-							"address":         "0x630a035be662260db9fe33cd58afd71f7e8f4fa3",
-							"config":          "",
+						OrderHash:    "0xce20127ae8ad845f825c28f045eb4c23cc23953bc4e3f9912bad938cbbb81bdc",
+						PaymentToken: "0x0000000000000000000000000000000000000000",
+						PaymentTokenContract: PaymentToken{
+							Address:  "0x0000000000000000000000000000000000000000",
+							Decimals: intPtr(18),
+							EthPrice: "1.000000000000000",
+							ID:       1,
+							ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+							Name:     "Ether",
+							Symbol:   "ETH",
+							UsdPrice: "2454.469999999999800000",
 						},
-						"maker_protocol_fee": "0",
-						"marked_invalid":     false,
-						"maker_referrer_fee": "0",
-						"payment_token":      "0x0000000000000000000000000000000000000000",
-						"target":             "0x495f947276749ce646f68ac8c248420045cb7b5e",
-						"payment_token_contract": map[string]interface{}{
-							"symbol":    "ETH",
-							"address":   "0x0000000000000000000000000000000000000000",
-							"image_url": "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
-							"name":      "Ether",
-							"decimals":  18.000000,
-							"eth_price": "1.000000000000000",
-							"usd_price": "2454.469999999999800000",
-							"id":        1.000000,
+						PrefixedHash:       "0x1d9ee936a552e56356c47a2d9e2085b5dd594805f3f96b6de25e46979359c9a2",
+						Quantity:           "1",
+						R:                  "0xb09c281aceaa7e3070038f65a379af01c3fabc103a33071a41cb663806b71c9f",
+						ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+						S:                  "0x496942ebbfc5d6911e40333130655f4a7cb895a4c9de2fa4c733918805eb2dd3",
+						SaleKind:           0,
+						Salt:               "25238348343466910035889375341869012537175615399929303660291003701733045393831",
+						Side:               1,
+						StaticExtradata:    "0x",
+						StaticTarget:       "0x0000000000000000000000000000000000000000",
+						Taker: Account{
+							Address:       "0x0000000000000000000000000000000000000000",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+							User: AccountUser{
+								ID: 1766,
+							},
 						},
-						"base_price":         "20000000000000000",
-						"finalized":          false,
-						"maker_relayer_fee":  "1150",
-						"taker_protocol_fee": "0",
-						"taker_relayer_fee":  "0",
-						"extra":              "0",
-						"closing_extendable": false,
-						"current_price":      "20000000000000000.00000000000",
-						"static_extradata":   "0x",
-						"quantity":           "1",
-						"salt":               "25238348343466910035889375341869012537175615399929303660291003701733045393831",
-						"v":                  28.000000,
-						"s":                  "0x496942ebbfc5d6911e40333130655f4a7cb895a4c9de2fa4c733918805eb2dd3",
-						"cancelled":          false,
-						"bounty_multiple":    "0.01",
-						"side":               1.000000,
-						"prefixed_hash":      "0x1d9ee936a552e56356c47a2d9e2085b5dd594805f3f96b6de25e46979359c9a2",
-					},
-				},
-				TokenID:                 "44796663663247546469885569892166981646365841902102129104966958429622287466596",
+						TakerProtocolFee: "0",
+						TakerRelayerFee:  "0",
+						Target:           "0x495f947276749ce646f68ac8c248420045cb7b5e",
+						V:                28,
+					},
+				},
+				TokenID:                 MustParseTokenID("44796663663247546469885569892166981646365841902102129104966958429622287466596"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -4546,11 +4464,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xae3d8d68b4f6c3ee784b2b0669885a315ba77c08",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-05-11T10:12:09.242556",
+					CreatedDate:                 MustParseTimestamp("2021-05-11T10:12:09.242556"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -4571,16 +4489,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/Z6d_prfpuybVigOXhuO8gt4ZTRTvhBayZXgrG419eZq2Ex6ptuodet4TCg8DLyc-E3otjIEatALGFGuuEje7CVreT4BbIPZjE0lKDw=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-05-11T10:44:47.345126",
+					CreatedDate:             MustParseTimestamp("2021-05-11T10:44:47.345126"),
 					DefaultToFiat:           false,
 					Description:             "",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/rtfkt",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://rtfkt.com/",
@@ -4594,8 +4512,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "RTFKT PUNK PROJECT GEN 1",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x6ea02aa94617f350d4b87a331b6b3dd2a1c6373a",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "verified",
@@ -4605,11 +4523,11 @@ var (
 					TwitterUsername:             "RTFKTStudios",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x623fc4f577926c0aadaef11a243754c546c1f98c",
 					Config:        "verified",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/14.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "RTFKT",
 					},
 				},
@@ -4624,24 +4542,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "RTFKT PUNK VXL Portrait #6095",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "RTFKT PUNK VXL Portrait #6095",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0xae3d8d68b4f6c3ee784b2b0669885a315ba77c08/448",
-				SellOrders:              nil,
-				TokenID:                 "448",
+				TokenID:                 MustParseTokenID("448"),
 				TokenMetadata:           "https://rtfkt.mypinata.cloud/ipfs/QmbJdVu4H1s4ukky7nFrfeUgV1EfCtNbeoPdzYUt6xaFfL",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -4650,11 +4565,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xae3d8d68b4f6c3ee784b2b0669885a315ba77c08",
 					AssetContractType:           "non-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2021-05-11T10:12:09.242556",
+					CreatedDate:                 MustParseTimestamp("2021-05-11T10:12:09.242556"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -4675,16 +4590,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/Z6d_prfpuybVigOXhuO8gt4ZTRTvhBayZXgrG419eZq2Ex6ptuodet4TCg8DLyc-E3otjIEatALGFGuuEje7CVreT4BbIPZjE0lKDw=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-05-11T10:44:47.345126",
+					CreatedDate:             MustParseTimestamp("2021-05-11T10:44:47.345126"),
 					DefaultToFiat:           false,
 					Description:             "",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/rtfkt",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://rtfkt.com/",
@@ -4698,8 +4613,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "RTFKT PUNK PROJECT GEN 1",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x6ea02aa94617f350d4b87a331b6b3dd2a1c6373a",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "verified",
@@ -4709,11 +4624,11 @@ var (
 					TwitterUsername:             "RTFKTStudios",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x623fc4f577926c0aadaef11a243754c546c1f98c",
 					Config:        "verified",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/14.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "RTFKT",
 					},
 				},
@@ -4728,24 +4643,21 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "RTFKT PUNK #6095 Sneakers",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "RTFKT PUNK #6095 Sneakers",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0xae3d8d68b4f6c3ee784b2b0669885a315ba77c08/447",
-				SellOrders:              nil,
-				TokenID:                 "447",
+				TokenID:                 MustParseTokenID("447"),
 				TokenMetadata:           "https://rtfkt.mypinata.cloud/ipfs/QmZDJyU9WF4UVm3CJSRP2H48Emj5xnW5Nq9cgzyoy42HE7",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -4754,11 +4666,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -4779,16 +4691,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/GNrqA8k9HpUoZ7hl7WRG5KMDzKJ5zizwfwMiaPrNKfi_EXLsJ5lMkKTj2F9xp1kfB4roGk-oF1GnsGHqP3ke8Hb4LA3YjUwaiu0liA=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-06-06T13:48:06.557441",
+					CreatedDate:             MustParseTimestamp("2021-06-06T13:48:06.557441"),
 					DefaultToFiat:           false,
 					Description:             "Kabarga (musk deer) is a cute artiodactyl with outstanding fangs. But, unfortunately, they are on the verge of extinction due to the unique musk gland. There are about 230,000 individuals left around the world. \n\nNTF makes it possible to remember and tell the whole world about the existence of such a cute animal. \n\n24x24 pixels",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "275",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 275,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -4802,8 +4714,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "CryptoKabarga",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x26ac155f40a4ac8fcac27b45d3bd38f17b928be2",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -4813,11 +4725,11 @@ var (
 					TwitterUsername:             "AzevNFT",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x26ac155f40a4ac8fcac27b45d3bd38f17b928be2",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/26.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "AzeV",
 					},
 				},
@@ -4832,24 +4744,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Kabarga #235",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Kabarga #235",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/17491933445981950493603605201939822143200868578689851698191638392729031933953",
-				SellOrders:              nil,
-				TokenID:                 "17491933445981950493603605201939822143200868578689851698191638392729031933953",
+				TokenID:                 MustParseTokenID("17491933445981950493603605201939822143200868578689851698191638392729031933953"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -4858,11 +4767,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-05-27T16:53:32.834583",
+					CreatedDate:                 MustParseTimestamp("2020-05-27T16:53:32.834583"),
 					DefaultToFiat:               false,
 					Description:                 "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
 					DevBuyerFeeBasisPoints:      0,
@@ -4883,16 +4792,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://storage.opensea.io/static/banners/rarible-banner4.png",
 					ChatURL:                 "",
-					CreatedDate:             "2020-01-01T13:22:57.777065",
+					CreatedDate:             MustParseTimestamp("2020-01-01T13:22:57.777065"),
 					DefaultToFiat:           false,
 					Description:             "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "https://rarible.com/",
@@ -4906,8 +4815,8 @@ var (
 					MediumUsername:              "rarible",
 					Name:                        "Rarible",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "approved",
@@ -4917,11 +4826,11 @@ var (
 					TwitterUsername:             "rariblecom",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xf4fcc2d19ae692a7aeb7d540139a37c7af1205c4",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/19.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "CryptoHallofFame",
 					},
 				},
@@ -4936,100 +4845,104 @@ var (
 				IsPresale:         false,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "623149",
+						TokenID:  MustParseTokenID("623149"),
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "McDonald's Punk",
-				NumSales:    282,
-				Owner: AssetOwner{
+				Name:     "McDonald's Punk",
+				NumSales: 282,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink: "https://opensea.io/assets/0xd07dc4262bcdbf85190c01c996b4c06a461d2430/623149",
-				SellOrders: []interface{}{
-					map[string]interface{}{
-						"order_hash":         "0x5691171c1e089230488cc92a49cce96b00694a360a247b3bf1ddd2050f02525e",
-						"v":                  28.000000,
-						"extra":              "0",
-						"closing_extendable": false,
-						"expiration_time":    0.000000,
-						"listing_time":       1628427311.000000,
-						"base_price":         "2000000000000000",
-						"static_target":      "0x0000000000000000000000000000000000000000",
-						"approved_on_chain":  false,
-						"metadata": map[string]interface{}{
-							"asset": map[string]interface{}{
-								"id":       "623149",
-								"address":  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
-								"quantity": "1",
+				SellOrders: []Order{
+					Order{
+						ApprovedOnChain:   false,
+						BasePrice:         MustParsePrice("2000000000000000", 18),
+						BountyMultiple:    "0.01",
+						Calldata:          "0xf242432a000000000000000000000000f4fcc2d19ae692a7aeb7d540139a37c7af1205c40000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000009822d000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+						Cancelled:         false,
+						ClosingExtendable: false,
+						CreatedDate:       MustParseTimestamp("2021-08-08T12:56:56.311163"),
+						CurrentBounty:     MustParsePrice("20000000000000", 18),
+						CurrentPrice:      MustParsePrice("2000000000000000", 18),
+						Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+						ExpirationTime:    UnixTimestamp(0),
+						Extra:             "0",
+						FeeMethod:         1,
+						FeeRecipient: Account{
+							Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+							Config:        "verified",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+							User: AccountUser{
+								ID: 3585,
 							},
-							"schema": "ERC1155",
 						},
-						"maker_relayer_fee": "250",
-						"taker_relayer_fee": "0",
-						"fee_recipient": map[string]interface{}{
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
-							"address":         "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
-							"config":          "verified",
-							"user":            3585.000000,
+						Finalized:   false,
+						HowToCall:   0,
+						ListingTime: UnixTimestamp(1628427311),
+						Maker: Account{
+							Address:       "0xf4fcc2d19ae692a7aeb7d540139a37c7af1205c4",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/19.png",
+							User: AccountUser{
+								ID: 146242,
+							},
 						},
-						"how_to_call":         0.000000,
-						"replacement_pattern": "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-						"payment_token":       "0x0000000000000000000000000000000000000000",
-						"prefixed_hash":       "0x73416d063ddd0df148aef76cdcc5bf8ed261a06d8e676fe19d5fcdeb80cfe2f7",
-						"created_date":        "2021-08-08T12:56:56.311163",
-						"maker": map[string]interface{}{
-							"user":            146242.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/19.png",
-							"address":         "0xf4fcc2d19ae692a7aeb7d540139a37c7af1205c4",
-							"config":          "",
+						MakerProtocolFee: "0",
+						MakerReferrerFee: "0",
+						MakerRelayerFee:  "250",
+						MarkedInvalid:    false,
+						Metadata: OrderMetadata{
+							Asset: OrderMetadataAsset{
+								Address:  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+								ID:       MustParseTokenID("623149"),
+								Quantity: "1",
+							},
+							Schema: "ERC1155",
 						},
-						"taker_protocol_fee": "0",
-						"side":               1.000000,
-						"calldata":           "0xf242432a000000000000000000000000f4fcc2d19ae692a7aeb7d540139a37c7af1205c40000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000009822d000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
-						"s":                  "0x7f4a4bd8b9eb4ca019c5788a4f0603bf6e0ff294012a40864f6c99ce6f03d6a6",
-						"closing_date":       nil,
-						"current_bounty":     "20000000000000",
-						"maker_referrer_fee": "0",
-						"fee_method":         1.000000,
-						"maker_protocol_fee": "0",
-						"sale_kind":          0.000000,
-						"payment_token_contract": map[string]interface{}{
-							"decimals":  18.000000,
-							"eth_price": "1.000000000000000",
-							"usd_price": "2454.469999999999800000",
-							"id":        1.000000,
-							"symbol":    "ETH",
-							"address":   "0x0000000000000000000000000000000000000000",
-							"image_url": "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
-							"name":      "Ether",
+						OrderHash:    "0x5691171c1e089230488cc92a49cce96b00694a360a247b3bf1ddd2050f02525e",
+						PaymentToken: "0x0000000000000000000000000000000000000000",
+						PaymentTokenContract: PaymentToken{
+							Address:  "0x0000000000000000000000000000000000000000",
+							Decimals: intPtr(18),
+							EthPrice: "1.000000000000000",
+							ID:       1,
+							ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+							Name:     "Ether",
+							Symbol:   "ETH",
+							UsdPrice: "2454.469999999999800000",
 						},
-						"quantity":  "1",
-						"r":         "0x7b65b98eb71fbed00320d1d218add76cbadfadb089838cd9e94eeaf9c55131ab",
-						"cancelled": false,
-						"finalized": false,
-						"taker": map[string]interface{}{
-							"user":            1766.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-							"address":         "0x0000000000000000000000000000000000000000",
-							"config":          "",
+						PrefixedHash:       "0x73416d063ddd0df148aef76cdcc5bf8ed261a06d8e676fe19d5fcdeb80cfe2f7",
+						Quantity:           "1",
+						R:                  "0x7b65b98eb71fbed00320d1d218add76cbadfadb089838cd9e94eeaf9c55131ab",
+						ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+						S:                  "0x7f4a4bd8b9eb4ca019c5788a4f0603bf6e0ff294012a40864f6c99ce6f03d6a6",
+						SaleKind:           0,
+						Salt:               "93370745741430907156003078065163160560754239366694834420543382570413961761057",
+						Side:               1,
+						StaticExtradata:    "0x",
+						StaticTarget:       "0x0000000000000000000000000000000000000000",
+						Taker: Account{
+							Address:       "0x0000000000000000000000000000000000000000",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+							User: AccountUser{
+								ID: 1766,
+							},
 						},
-						"bounty_multiple":  "0.01",
-						"static_extradata": "0x",
-						"salt":             "93370745741430907156003078065163160560754239366694834420543382570413961761057",
-						"exchange":         "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
-						"current_price":    "2000000000000000",
-						"target":           "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
-						"marked_invalid":   false,
+						TakerProtocolFee: "0",
+						TakerRelayerFee:  "0",
+						Target:           "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+						V:                28,
 					},
 				},
-				TokenID:                 "623149",
+				TokenID:                 MustParseTokenID("623149"),
 				TokenMetadata:           "https://opensea.mypinata.cloud/ipfs/QmbT529ceEV2xYvYARr8rvS7dXLqC3CALpQLTGgWJw1esw",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -5038,11 +4951,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -5063,16 +4976,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/oyuJIxA7-a3_jjDV8RYCZm5Q895DI2QfZCWLwRhReHtwdIija7amPSp7jE_j0Tkzw6AdIaTwv9lb5O3rxPGw6hihKS7LRK7gvFKZGMk=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-05-07T00:51:49.294307",
+					CreatedDate:             MustParseTimestamp("2021-05-07T00:51:49.294307"),
 					DefaultToFiat:           false,
 					Description:             "⭐️ This is a StarBits. They are damn cool and they must be in the crypto universe! ⭐️\r\n\r\nStarBits is a digital art project on the Ethereum Blockchain inspired of the Meebits and is not affilliated with LarvaLabs.\r\n\r\n\r\n\r\nOwner extras:\r\n\r\n• StarBits owners can access a T-pose OBJ file that be imported into any most standard 3D software.\r\n\r\n• Included high resolution, lossless render 2400x3600 pixels.\r\n\r\n\r\n\r\n1-20 -> 0.029 Ξ [SOLD]\r\n\r\n21-50 -> 0.069 Ξ [SOLD]\r\n\r\n51-100 -> 0.079 Ξ [SOLD]\r\n\r\n101-150 -> 0.079 Ξ [SOLD]\r\n\r\n151-200 -> 0.089 Ξ \r\n\r\n201-250 -> ?.?? Ξ [Currently minting]\r\n\r\n251-300 -> ?.?? Ξ\r\n\r\n301-303 -> ?.?? Ξ\r\n\r\n✨Special:\r\n\r\n🦸🏻\u200d♂️Only 50 StarBits with a themed background \r\n\r\n🦹🏻\u200d♂️ Only 25 StarBits animated ones with a themed background\r\n\r\nWelcome to our Community! \r\n\r\ndiscord.gg/392cWNKhSQ",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "200",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 200,
 					DiscordURL:              "https://discord.gg/392cWNKhSQ",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -5086,8 +4999,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "StarBits",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x0f23dfe19b260a41c33caed1a692e68efb04bdc8",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -5097,11 +5010,11 @@ var (
 					TwitterUsername:             "cryptorarity",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x0f23dfe19b260a41c33caed1a692e68efb04bdc8",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/12.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "StarBits",
 					},
 				},
@@ -5116,24 +5029,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "StarBit 180 Jay-Z",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "StarBit 180 Jay-Z",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/6848077547778627665853998704942827424505195005951679691492820382292742504449",
-				SellOrders:              nil,
-				TokenID:                 "6848077547778627665853998704942827424505195005951679691492820382292742504449",
+				TokenID:                 MustParseTokenID("6848077547778627665853998704942827424505195005951679691492820382292742504449"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -5142,11 +5052,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/40ccd2f213a1319ec0dfe1a4e2105548.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/40ccd2f213a1319ec0dfe1a4e2105548.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -5167,16 +5077,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "",
 					ChatURL:                 "",
-					CreatedDate:             "2021-06-25T16:41:41.088528",
+					CreatedDate:             MustParseTimestamp("2021-06-25T16:41:41.088528"),
 					DefaultToFiat:           false,
 					Description:             "Random acts of kindness",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -5190,8 +5100,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Gifts V4",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -5201,11 +5111,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x8ee376de530fb9a734df676e7e4342b48355f483",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/11.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "DappPunk",
 					},
 				},
@@ -5220,24 +5130,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "One of us",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "One of us",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/64630319182452990969487203883268678555211445410905438532285660976559924707329",
-				SellOrders:              nil,
-				TokenID:                 "64630319182452990969487203883268678555211445410905438532285660976559924707329",
+				TokenID:                 MustParseTokenID("64630319182452990969487203883268678555211445410905438532285660976559924707329"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -5246,11 +5153,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/f614777ce75ba98555aadff3c9b4bc72.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/f614777ce75ba98555aadff3c9b4bc72.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -5271,16 +5178,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/72Tc8sp-PbTl5dTDewZV9zWcDiwHsdp1vy7fy3_n8fuosIl3siEUA7uFQkTviNDP1pwpGGqzAXT4DDgLxp3MmUaung7AVr2SdfflRfI=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-06-03T03:32:16.946681",
+					CreatedDate:             MustParseTimestamp("2021-06-03T03:32:16.946681"),
 					DefaultToFiat:           false,
 					Description:             "This collection of digital pottery was hand spun and painted. Every vessel is full of love! There is only one of each color released at a time and the next series in that color is released as each original is sold. Pick your favorite and spread the love!",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -5294,8 +5201,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Handspun by Brooklyn",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x7775ec3e9b1c582786f3985761aa19b02ef6bbc7",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -5305,11 +5212,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x7775ec3e9b1c582786f3985761aa19b02ef6bbc7",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/27.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "HandspunByBrooklyn",
 					},
 				},
@@ -5324,24 +5231,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Handspun Pottery: The Beyonce ",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Handspun Pottery: The Beyonce ",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/54033580587977372518571799806958086599703350828421537884140822715178063757313",
-				SellOrders:              nil,
-				TokenID:                 "54033580587977372518571799806958086599703350828421537884140822715178063757313",
+				TokenID:                 MustParseTokenID("54033580587977372518571799806958086599703350828421537884140822715178063757313"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -5350,11 +5254,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -5375,16 +5279,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/bk1ZANGrRXPwI4Uv2ZdqvTdu6HTp6tTIMKQ7bp0nGqoovMYpuEGIyOno1NqjXV3pQiVX99O7Di3D_Rj3H987vegyVkJLDAdLkEt04w=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-05-11T17:56:13.550438",
+					CreatedDate:             MustParseTimestamp("2021-05-11T17:56:13.550438"),
 					DefaultToFiat:           false,
 					Description:             "Tired of CryptoPunks? I know you are. \nSo lets smash it! And look how they are shattered to pieces.\nAll smashes are physically correct and procedurally genereated.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "padded",
 					},
 					ExternalURL:                 "",
@@ -5398,8 +5302,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Smashing CryptoPunks",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x23bf539abe3ecf714d3afe94f32c0867513c370e",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -5409,11 +5313,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x23bf539abe3ecf714d3afe94f32c0867513c370e",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/33.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "ArseniyKey",
 					},
 				},
@@ -5428,24 +5332,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Smashing CryptoPunk #6095",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Smashing CryptoPunk #6095",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/16168994506600739443055327821750519286665620275843895712348631005001670459393",
-				SellOrders:              nil,
-				TokenID:                 "16168994506600739443055327821750519286665620275843895712348631005001670459393",
+				TokenID:                 MustParseTokenID("16168994506600739443055327821750519286665620275843895712348631005001670459393"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -5454,11 +5355,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "",
 				AnimationURL:         "",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -5479,16 +5380,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/nleqTuw88TXkciOeFazDJUgr2oU5Cw7mQ1c_ivTZJi0oOOqRRFtYzkK4TGwFN4cnsCHb_6QD_OvEuKwGt29p-VlGVoaBCOXKkNjRvQ=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-05-28T12:15:08.280887",
+					CreatedDate:             MustParseTimestamp("2021-05-28T12:15:08.280887"),
 					DefaultToFiat:           false,
 					Description:             "Voxel Punkbits\n\nThere will be a total of 500 Voxel PunkBits all 1/1s.\n\n👽 Alien - 6\n🦧 Ape - 12 \n🧟\u200d♂️ Zombie - 28 \n👫 Male/Female - 454\n\nVoxel punkbits drops every day.\n\nPricing\n\n#1 - #100: - Ξ0.05 eth \n\n#101 - #500: - Ξ0.1 eth\n\nVox files will be provided once all PunkBits have been minted. Also the clothing will come after all PunkBits have been minted. Won't be naked forever.\n\nRoadmap can be found in the discord server.\n\nExcited to see you all join the Voxel PunkBits family.\n\n[TWITTER](https://twitter.com/voxelpunkbits) / [DISCORD](https://discord.gg/92xZMQS3u9)\n\nNot affiliated with Larva Labs.",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "1000",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 1000,
 					DiscordURL:              "https://discord.gg/92xZMQS3u9",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -5502,8 +5403,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Voxel PunkBits",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "0x98088fc3a6cfebccdc35d54ff216518ff2075ecd",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -5513,11 +5414,11 @@ var (
 					TwitterUsername:             "voxelpunkbits",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0x98088fc3a6cfebccdc35d54ff216518ff2075ecd",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/20.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "0X9808",
 					},
 				},
@@ -5532,24 +5433,21 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Voxel punkbit #169",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Voxel punkbit #169",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink:               "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/68766679985659283446568862650133206908644067428778389194224096981508807458817",
-				SellOrders:              nil,
-				TokenID:                 "68766679985659283446568862650133206908644067428778389194224096981508807458817",
+				TokenID:                 MustParseTokenID("68766679985659283446568862650133206908644067428778389194224096981508807458817"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -5558,11 +5456,11 @@ var (
 			Asset{
 				AnimationOriginalURL: "https://storage.opensea.io/files/d16f9bd79e0c7d997993c1eec5992d58.mp4",
 				AnimationURL:         "https://storage.opensea.io/files/d16f9bd79e0c7d997993c1eec5992d58.mp4",
-				AssetContract: AssetAssetContract{
+				AssetContract: AssetContract{
 					Address:                     "0x495f947276749ce646f68ac8c248420045cb7b5e",
 					AssetContractType:           "semi-fungible",
 					BuyerFeeBasisPoints:         0,
-					CreatedDate:                 "2020-12-02T17:40:53.232025",
+					CreatedDate:                 MustParseTimestamp("2020-12-02T17:40:53.232025"),
 					DefaultToFiat:               false,
 					Description:                 "",
 					DevBuyerFeeBasisPoints:      0,
@@ -5583,16 +5481,16 @@ var (
 					TotalSupply:                 "",
 				},
 				BackgroundColor: "",
-				Collection: AssetCollection{
+				Collection: Collection{
 					BannerImageURL:          "https://lh3.googleusercontent.com/RKXBRUNgMGRdJzAXPQ_Juxb4xHITXmGMrth38sh5n2Ho23mJUWgoszVqVGMEI8cbl9sNUVfgx0rqyBVohaqY1r_aLAXMcmhPa7NN=s2500",
 					ChatURL:                 "",
-					CreatedDate:             "2021-06-25T13:59:25.871393",
+					CreatedDate:             MustParseTimestamp("2021-06-25T13:59:25.871393"),
 					DefaultToFiat:           false,
 					Description:             "Bam! There it goes...",
-					DevBuyerFeeBasisPoints:  "0",
-					DevSellerFeeBasisPoints: "0",
+					DevBuyerFeeBasisPoints:  0,
+					DevSellerFeeBasisPoints: 0,
 					DiscordURL:              "",
-					DisplayData: AssetDisplayData{
+					DisplayData: CollectionDisplayData{
 						CardDisplayStyle: "contain",
 					},
 					ExternalURL:                 "",
@@ -5606,8 +5504,8 @@ var (
 					MediumUsername:              "",
 					Name:                        "Exploding Watches",
 					OnlyProxiedTransfers:        false,
-					OpenseaBuyerFeeBasisPoints:  "0",
-					OpenseaSellerFeeBasisPoints: "250",
+					OpenseaBuyerFeeBasisPoints:  0,
+					OpenseaSellerFeeBasisPoints: 250,
 					PayoutAddress:               "",
 					RequireEmail:                false,
 					SafelistRequestStatus:       "not_requested",
@@ -5617,11 +5515,11 @@ var (
 					TwitterUsername:             "",
 					WikiURL:                     "",
 				},
-				Creator: AssetCreator{
+				Creator: Account{
 					Address:       "0xc91915d01bb96ec08b9b5fd767034de9cd390f17",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/4.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "Mulletizer",
 					},
 				},
@@ -5636,100 +5534,103 @@ var (
 				IsPresale:         true,
 				LastSale: AssetLastSale{
 					Asset: AssetLastSaleAsset{
-						TokenID:  "",
 						Decimals: 0,
 					},
 				},
-				ListingDate: "",
-				Name:        "Exploding Patti",
-				NumSales:    0,
-				Owner: AssetOwner{
+				Name:     "Exploding Patti",
+				NumSales: 0,
+				Owner: Account{
 					Address:       "0x0000000000000000000000000000000000000000",
 					Config:        "",
 					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-					User: AssetUser{
+					User: AccountUser{
 						Username: "NullAddress",
 					},
 				},
 				Permalink: "https://opensea.io/assets/0x495f947276749ce646f68ac8c248420045cb7b5e/90959204289117907473089055551793551147228941711805548667083151429043922927716",
-				SellOrders: []interface{}{
-					map[string]interface{}{
-						"salt":               "23001191982592676231179375925642885194657817519077254338642413489656489976206",
-						"taker_relayer_fee":  "0",
-						"target":             "0x495f947276749ce646f68ac8c248420045cb7b5e",
-						"calldata":           "0xf242432a000000000000000000000000c91915d01bb96ec08b9b5fd767034de9cd390f170000000000000000000000000000000000000000000000000000000000000000c91915d01bb96ec08b9b5fd767034de9cd390f17000000000000020000000064000000000000000000000000000000000000000000000000000000000000005a00000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
-						"taker_protocol_fee": "0",
-						"sale_kind":          0.000000,
-						"static_target":      "0x0000000000000000000000000000000000000000",
-						"r":                  "0x655ba249fe395d0a0234495df341fd5b53a1ec48ec76f58dbad3121194de4a10",
-						"s":                  "0x11a1954d389ca17f2837237675b71271ecc33ed613f6b226e011b168d5e5dc94",
-						"created_date":       "2021-06-25T14:25:14.818666",
-						"closing_extendable": false,
-						"maker_relayer_fee":  "250",
-						"marked_invalid":     false,
-						"prefixed_hash":      "0x312bb44e481b8c870613d427b4e710a36c3f2140ece8ca957ce8133e79adbbb1",
-						"taker": map[string]interface{}{
-							"user":            1766.000000,
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
-							"address":         "0x0000000000000000000000000000000000000000",
-							"config":          "",
+				SellOrders: []Order{
+					Order{
+						ApprovedOnChain:   false,
+						BasePrice:         MustParsePrice("8100000000000000000", 18),
+						BountyMultiple:    "0.01",
+						Calldata:          "0xf242432a000000000000000000000000c91915d01bb96ec08b9b5fd767034de9cd390f170000000000000000000000000000000000000000000000000000000000000000c91915d01bb96ec08b9b5fd767034de9cd390f17000000000000020000000064000000000000000000000000000000000000000000000000000000000000005a00000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+						Cancelled:         false,
+						ClosingExtendable: false,
+						CreatedDate:       MustParseTimestamp("2021-06-25T14:25:14.818666"),
+						CurrentBounty:     MustParsePrice("81000000000000000", 18),
+						CurrentPrice:      MustParsePrice("8100000000000000000", 18),
+						Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+						ExpirationTime:    UnixTimestamp(0),
+						Extra:             "0",
+						FeeMethod:         1,
+						FeeRecipient: Account{
+							Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+							Config:        "verified",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+							User: AccountUser{
+								ID: 3585,
+							},
 						},
-						"side":                1.000000,
-						"replacement_pattern": "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-						"current_bounty":      "81000000000000000",
-						"fee_method":          1.000000,
-						"how_to_call":         0.000000,
-						"cancelled":           false,
-						"closing_date":        nil,
-						"order_hash":          "0x7d3b16e1ca6134e41663f478aa2f69fc9a5caba983e59ac29d4c75825208c181",
-						"metadata": map[string]interface{}{
-							"schema": "ERC1155",
-							"asset": map[string]interface{}{
-								"id":       "90959204289117907473089055551793551147228941711805548667083151429043922927716",
-								"address":  "0x495f947276749ce646f68ac8c248420045cb7b5e",
-								"quantity": "90",
+						Finalized:   false,
+						HowToCall:   0,
+						ListingTime: UnixTimestamp(1624631006),
+						Maker: Account{
+							Address:       "0xc91915d01bb96ec08b9b5fd767034de9cd390f17",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/4.png",
+							User: AccountUser{
+								ID: 389228,
 							},
 						},
-						"bounty_multiple": "0.01",
-						"payment_token":   "0x0000000000000000000000000000000000000000",
-						"payment_token_contract": map[string]interface{}{
-							"image_url": "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
-							"name":      "Ether",
-							"decimals":  18.000000,
-							"eth_price": "1.000000000000000",
-							"usd_price": "2454.469999999999800000",
-							"id":        1.000000,
-							"symbol":    "ETH",
-							"address":   "0x0000000000000000000000000000000000000000",
+						MakerProtocolFee: "0",
+						MakerReferrerFee: "0",
+						MakerRelayerFee:  "250",
+						MarkedInvalid:    false,
+						Metadata: OrderMetadata{
+							Asset: OrderMetadataAsset{
+								Address:  "0x495f947276749ce646f68ac8c248420045cb7b5e",
+								ID:       MustParseTokenID("90959204289117907473089055551793551147228941711805548667083151429043922927716"),
+								Quantity: "90",
+							},
+							Schema: "ERC1155",
 						},
-						"base_price":        "8100000000000000000",
-						"quantity":          "90",
-						"listing_time":      1624631006.000000,
-						"exchange":          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
-						"current_price":     "8100000000000000000",
-						"expiration_time":   0.000000,
-						"v":                 28.000000,
-						"finalized":         false,
-						"static_extradata":  "0x",
-						"approved_on_chain": false,
-						"maker": map[string]interface{}{
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/4.png",
-							"address":         "0xc91915d01bb96ec08b9b5fd767034de9cd390f17",
-							"config":          "",
-							"user":            389228.000000,
+						OrderHash:    "0x7d3b16e1ca6134e41663f478aa2f69fc9a5caba983e59ac29d4c75825208c181",
+						PaymentToken: "0x0000000000000000000000000000000000000000",
+						PaymentTokenContract: PaymentToken{
+							Address:  "0x0000000000000000000000000000000000000000",
+							Decimals: intPtr(18),
+							EthPrice: "1.000000000000000",
+							ID:       1,
+							ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+							Name:     "Ether",
+							Symbol:   "ETH",
+							UsdPrice: "2454.469999999999800000",
 						},
-						"maker_protocol_fee": "0",
-						"maker_referrer_fee": "0",
-						"fee_recipient": map[string]interface{}{
-							"profile_img_url": "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
-							"address":         "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
-							"config":          "verified",
-							"user":            3585.000000,
+						PrefixedHash:       "0x312bb44e481b8c870613d427b4e710a36c3f2140ece8ca957ce8133e79adbbb1",
+						Quantity:           "90",
+						R:                  "0x655ba249fe395d0a0234495df341fd5b53a1ec48ec76f58dbad3121194de4a10",
+						ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+						S:                  "0x11a1954d389ca17f2837237675b71271ecc33ed613f6b226e011b168d5e5dc94",
+						SaleKind:           0,
+						Salt:               "23001191982592676231179375925642885194657817519077254338642413489656489976206",
+						Side:               1,
+						StaticExtradata:    "0x",
+						StaticTarget:       "0x0000000000000000000000000000000000000000",
+						Taker: Account{
+							Address:       "0x0000000000000000000000000000000000000000",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+							User: AccountUser{
+								ID: 1766,
+							},
 						},
-						"extra": "0",
+						TakerProtocolFee: "0",
+						TakerRelayerFee:  "0",
+						Target:           "0x495f947276749ce646f68ac8c248420045cb7b5e",
+						V:                28,
 					},
 				},
-				TokenID:                 "90959204289117907473089055551793551147228941711805548667083151429043922927716",
+				TokenID:                 MustParseTokenID("90959204289117907473089055551793551147228941711805548667083151429043922927716"),
 				TokenMetadata:           "",
 				TopBid:                  "",
 				TransferFee:             "",
@@ -5826,23 +5727,23 @@ var (
 			"0x6fb94110aad7d1dbe711fc80febb552bb9f52a25",
 			"0x9f6ac750a020d3141838cbf35b444fd5b732ec7d",
 		},
-		PaymentTokens: []CollectionPaymentTokens{
-			{ID: 4645681, Symbol: "WETH", Address: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", ImageURL: "https://storage.opensea.io/files/accae6b6fb3888cbff27a013729c22dc.svg", Name: "Wrapped Ether", Decimals: 18, EthPrice: 1, UsdPrice: 2617.42},
-			{ID: 4403908, Symbol: "USDC", Address: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", ImageURL: "https://storage.opensea.io/files/749015f009a66abcb3bbb3502ae2f1ce.svg", Name: "USD Coin", Decimals: 6, EthPrice: 0.00038164, UsdPrice: 1},
-			{ID: 13689077, Symbol: "ETH", Address: "0x0000000000000000000000000000000000000000", ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg", Name: "Ether", Decimals: 18, EthPrice: 1, UsdPrice: 2617.42},
-			{ID: 12182941, Symbol: "DAI", Address: "0x6b175474e89094c44da98b954eedeac495271d0f", ImageURL: "https://storage.opensea.io/files/8ef8fb3fe707f693e57cdbfea130c24c.svg", Name: "Dai Stablecoin", Decimals: 18, EthPrice: 0.00038193, UsdPrice: 1},
+		PaymentTokens: []PaymentToken{
+			{ID: 4645681, Symbol: "WETH", Address: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", ImageURL: "https://storage.opensea.io/files/accae6b6fb3888cbff27a013729c22dc.svg", Name: "Wrapped Ether", Decimals: intPtr(18), EthPrice: "1", UsdPrice: "2617.42"},
+			{ID: 4403908, Symbol: "USDC", Address: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", ImageURL: "https://storage.opensea.io/files/749015f009a66abcb3bbb3502ae2f1ce.svg", Name: "USD Coin", Decimals: intPtr(6), EthPrice: "0.00038164", UsdPrice: "1"},
+			{ID: 13689077, Symbol: "ETH", Address: "0x0000000000000000000000000000000000000000", ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg", Name: "Ether", Decimals: intPtr(18), EthPrice: "1", UsdPrice: "2617.42"},
+			{ID: 12182941, Symbol: "DAI", Address: "0x6b175474e89094c44da98b954eedeac495271d0f", ImageURL: "https://storage.opensea.io/files/8ef8fb3fe707f693e57cdbfea130c24c.svg", Name: "Dai Stablecoin", Decimals: intPtr(18), EthPrice: "0.00038193", UsdPrice: "1"},
 		},
-		PrimaryAssetContracts: []CollectionPrimaryAssetContracts{
-			{Address: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", AssetContractType: "non-fungible", CreatedDate: "2021-04-22T03:03:43.731860", Name: "BoredApeYachtClub", NftVersion: "3.0", OpenseaVersion: "", Owner: 34522873, SchemaName: "ERC721", Symbol: "BAYC", TotalSupply: "0", Description: "The Bored Ape Yacht Club is a collection of 10,000 unique Bored Ape NFTs— unique digital collectibles living on the Ethereum blockchain. Your Bored Ape doubles as your Yacht Club membership card, and grants access to members-only benefits, the first of which is access to THE BATHROOM, a collaborative graffiti board. Future areas and perks can be unlocked by the community through roadmap activation. Visit www.BoredApeYachtClub.com for more details.", ExternalLink: "http://www.boredapeyachtclub.com/", ImageURL: "https://lh3.googleusercontent.com/Ju9CkWtV-1Okvf45wo8UctR-M9He2PjILP0oOvxE89AyiPPGtrR3gysu1Zgy0hjd2xKIgjJJtWIc0ybj4Vd7wv8t3pxDGHoJBzDB=s120", DefaultToFiat: false, DevBuyerFeeBasisPoints: 0, DevSellerFeeBasisPoints: 250, OnlyProxiedTransfers: false, OpenseaBuyerFeeBasisPoints: 0, OpenseaSellerFeeBasisPoints: 250, BuyerFeeBasisPoints: 0, SellerFeeBasisPoints: 500, PayoutAddress: "0xaae7ac476b117bccafe2f05f582906be44bc8ff1"},
+		PrimaryAssetContracts: []AssetContract{
+			{Address: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", AssetContractType: "non-fungible", CreatedDate: MustParseTimestamp("2021-04-22T03:03:43.731860"), Name: "BoredApeYachtClub", NftVersion: "3.0", OpenseaVersion: "", Owner: 34522873, SchemaName: "ERC721", Symbol: "BAYC", TotalSupply: "0", Description: "The Bored Ape Yacht Club is a collection of 10,000 unique Bored Ape NFTs— unique digital collectibles living on the Ethereum blockchain. Your Bored Ape doubles as your Yacht Club membership card, and grants access to members-only benefits, the first of which is access to THE BATHROOM, a collaborative graffiti board. Future areas and perks can be unlocked by the community through roadmap activation. Visit www.BoredApeYachtClub.com for more details.", ExternalLink: "http://www.boredapeyachtclub.com/", ImageURL: "https://lh3.googleusercontent.com/Ju9CkWtV-1Okvf45wo8UctR-M9He2PjILP0oOvxE89AyiPPGtrR3gysu1Zgy0hjd2xKIgjJJtWIc0ybj4Vd7wv8t3pxDGHoJBzDB=s120", DefaultToFiat: false, DevBuyerFeeBasisPoints: 0, DevSellerFeeBasisPoints: 250, OnlyProxiedTransfers: false, OpenseaBuyerFeeBasisPoints: 0, OpenseaSellerFeeBasisPoints: 250, BuyerFeeBasisPoints: 0, SellerFeeBasisPoints: 500, PayoutAddress: "0xaae7ac476b117bccafe2f05f582906be44bc8ff1"},
 		},
 		Stats:                       CollectionStats{OneDayVolume: 7220.968899999999, OneDayChange: 0.5058357695612996, OneDaySales: 57, OneDayAveragePrice: 126.68366491228068, SevenDayVolume: 28085.562700000006, SevenDayChange: 1.3760080647805017, SevenDaySales: 269, SevenDayAveragePrice: 104.40729628252791, ThirtyDayVolume: 83957.6926970619, ThirtyDayChange: 1.3326166299956324, ThirtyDaySales: 915, ThirtyDayAveragePrice: 91.75704119897475, TotalVolume: 364773.71301449905, TotalSales: 24373, TotalSupply: 10000, Count: 10000, NumOwners: 6223, AveragePrice: 14.966303410105406, NumReports: 27, MarketCap: 1.044072962825279e+06, FloorPrice: 106.9},
 		BannerImageURL:              "https://lh3.googleusercontent.com/i5dYZRkVCUK97bfprQ3WXyrT9BnLSZtVKGJlKQ919uaUB0sxbngVCioaiyu9r6snqfi2aaTyIvv6DHm4m2R3y7hMajbsv14pSZK8mhs=s2500",
 		ChatURL:                     "",
-		CreatedDate:                 "2021-04-22T23:14:03.967121",
+		CreatedDate:                 MustParseTimestamp("2021-04-22T23:14:03.967121"),
 		DefaultToFiat:               false,
 		Description:                 "The Bored Ape Yacht Club is a collection of 10,000 unique Bored Ape NFTs— unique digital collectibles living on the Ethereum blockchain. Your Bored Ape doubles as your Yacht Club membership card, and grants access to members-only benefits, the first of which is access to THE BATHROOM, a collaborative graffiti board. Future areas and perks can be unlocked by the community through roadmap activation. Visit www.BoredApeYachtClub.com for more details.",
-		DevBuyerFeeBasisPoints:      "0",
-		DevSellerFeeBasisPoints:     "250",
+		DevBuyerFeeBasisPoints:      0,
+		DevSellerFeeBasisPoints:     250,
 		DiscordURL:                  "https://discord.gg/3P5K3dzgdB",
 		DisplayData:                 CollectionDisplayData{CardDisplayStyle: "contain"},
 		ExternalURL:                 "http://www.boredapeyachtclub.com/",
@@ -5856,8 +5757,8 @@ var (
 		MediumUsername:              "",
 		Name:                        "Bored Ape Yacht Club",
 		OnlyProxiedTransfers:        false,
-		OpenseaBuyerFeeBasisPoints:  "0",
-		OpenseaSellerFeeBasisPoints: "250",
+		OpenseaBuyerFeeBasisPoints:  0,
+		OpenseaSellerFeeBasisPoints: 250,
 		PayoutAddress:               "0xaae7ac476b117bccafe2f05f582906be44bc8ff1",
 		RequireEmail:                false,
 		ShortDescription:            "",
@@ -5867,4 +5768,984 @@ var (
 		InstagramUsername:           "boredapeyachtclub",
 		WikiURL:                     "",
 	}
+	FixtureGetOrdersResp = GetOrdersResponse{
+		Count: 2,
+		Orders: []Order{
+			Order{
+				ApprovedOnChain:   false,
+				Asset:             &FixtureGetAssetsResp.Assets[6],
+				BasePrice:         MustParsePrice("236700000000000000", 18),
+				BountyMultiple:    "0.01",
+				Calldata:          "0xf242432a000000000000000000000000409753d7a885abdc28ff470dfa82bc448b683bf400000000000000000000000000000000000000000000000000000000000000006da705478b5c1fd9cac9e664015c0db98bd1a3a0000000000000030000000384000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+				Cancelled:         false,
+				ClosingDate:       MustParseTimestamp("2022-01-30T09:09:59"),
+				ClosingExtendable: false,
+				CreatedDate:       MustParseTimestamp("2022-01-27T09:11:06.928325"),
+				CurrentBounty:     MustParsePrice("2367000000000000", 18),
+				CurrentPrice:      MustParsePrice("236700000000000000.0000000000", 18),
+				Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+				ExpirationTime:    UnixTimestamp(1643533799),
+				Extra:             "0",
+				FeeMethod:         1,
+				FeeRecipient: Account{
+					Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+					Config:        "verified",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+					User: AccountUser{
+						Username: "OS-Wallet",
+					},
+				},
+				Finalized:   false,
+				HowToCall:   0,
+				ListingTime: UnixTimestamp(1643274550),
+				Maker: Account{
+					Address:       "0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+					User: AccountUser{
+						Username: "",
+					},
+				},
+				MakerProtocolFee: "0",
+				MakerReferrerFee: "0",
+				MakerRelayerFee:  "1250",
+				MarkedInvalid:    false,
+				Metadata: OrderMetadata{
+					Asset: OrderMetadataAsset{
+						Address:  "0x495f947276749ce646f68ac8c248420045cb7b5e",
+						ID:       MustParseTokenID("49597200392958280165177755798765298064312831948909620000388784438348531893124"),
+						Quantity: "3",
+					},
+					Schema: "ERC1155",
+				},
+				OrderHash:    "0x6082b1dd3bb5ec23e19aba6c9d7c4ab980cd5374b1a6cb05b990fb0d094bd01f",
+				PaymentToken: "0x0000000000000000000000000000000000000000",
+				PaymentTokenContract: PaymentToken{
+					Address:  "0x0000000000000000000000000000000000000000",
+					Decimals: intPtr(18),
+					EthPrice: "1.000000000000000",
+					ID:       1,
+					ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+					Name:     "Ether",
+					Symbol:   "ETH",
+					UsdPrice: "2454.469999999999800000",
+				},
+				PrefixedHash:       "0x00b942524e717cc0a376356b81f9d0f0dac529d86165f8ee81505cd29a856b12",
+				Quantity:           "3",
+				R:                  "0x9d5aa554697b990bb7ff986deee5a7cef3c05062d0869dd00432c518deeb6a3f",
+				ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+				S:                  "0x4113d8ecd62a4364f7ec63bca167351f4907010265c615302d4bb500bbf23b24",
+				SaleKind:           0,
+				Salt:               "26535559511018809092298610860233562045941284456400966490545275593494424472844",
+				Side:               1,
+				StaticExtradata:    "0x",
+				StaticTarget:       "0x0000000000000000000000000000000000000000",
+				Taker: Account{
+					Address:       "0x0000000000000000000000000000000000000000",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+					User: AccountUser{
+						Username: "",
+					},
+				},
+				TakerProtocolFee: "0",
+				TakerRelayerFee:  "0",
+				Target:           "0x495f947276749ce646f68ac8c248420045cb7b5e",
+				V:                27,
+			},
+			Order{
+				ApprovedOnChain:   false,
+				Asset:             &FixtureGetAssetsResp.Assets[21],
+				BasePrice:         MustParsePrice("80000000000000000", 18),
+				BountyMultiple:    "0.01",
+				Calldata:          "0xf242432a000000000000000000000000a432cf92dcb8636cbf697f1c1c8076bb7f82f314000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a67e2000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+				Cancelled:         false,
+				ClosingExtendable: false,
+				CreatedDate:       MustParseTimestamp("2021-08-26T18:59:42.647990"),
+				CurrentBounty:     MustParsePrice("800000000000000", 18),
+				CurrentPrice:      MustParsePrice("80000000000000000", 18),
+				Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+				ExpirationTime:    UnixTimestamp(0),
+				Extra:             "0",
+				FeeMethod:         1,
+				FeeRecipient: Account{
+					Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+					Config:        "verified",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+					User: AccountUser{
+						Username: "OS-Wallet",
+					},
+				},
+				Finalized:   false,
+				HowToCall:   0,
+				ListingTime: UnixTimestamp(1630004273),
+				Maker: Account{
+					Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+					User: AccountUser{
+						Username: "",
+					},
+				},
+				MakerProtocolFee: "0",
+				MakerReferrerFee: "0",
+				MakerRelayerFee:  "250",
+				MarkedInvalid:    false,
+				Metadata: OrderMetadata{
+					Asset: OrderMetadataAsset{
+						Address:  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+						ID:       MustParseTokenID("681954"),
+						Quantity: "1",
+					},
+					Schema: "ERC1155",
+				},
+				OrderHash:    "0x30665f7d6a09eca98999a2a4ef529f3847f2495ff2d6f1f8b62d44417a44c75d",
+				PaymentToken: "0x0000000000000000000000000000000000000000",
+				PaymentTokenContract: PaymentToken{
+					Address:  "0x0000000000000000000000000000000000000000",
+					Decimals: intPtr(18),
+					EthPrice: "1.000000000000000",
+					ID:       1,
+					ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+					Name:     "Ether",
+					Symbol:   "ETH",
+					UsdPrice: "2454.469999999999800000",
+				},
+				PrefixedHash:       "0xc4aee32f1a2ca3ccbb2ee832acf479bcc952990e7b52c0df5092eff45ab4aaef",
+				Quantity:           "1",
+				R:                  "0x5a473b96b01b84e04987426e05d3f380ffa231b41de4f0b349b8b8e8f457871b",
+				ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+				S:                  "0x7f481d13466b77c513fe23519abb3012d807764c4bafb73ca45a5fc5f9690b05",
+				SaleKind:           0,
+				Salt:               "37807066599228839761082381987094645843543820864275911452860088185447967723074",
+				Side:               1,
+				StaticExtradata:    "0x",
+				StaticTarget:       "0x0000000000000000000000000000000000000000",
+				Taker: Account{
+					Address:       "0x0000000000000000000000000000000000000000",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+					User: AccountUser{
+						Username: "",
+					},
+				},
+				TakerProtocolFee: "0",
+				TakerRelayerFee:  "0",
+				Target:           "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+				V:                27,
+			},
+		},
+	}
+	FixtureGetAssetResp = Asset{
+		AnimationOriginalURL: "https://ipfs.io/ipfs/QmPDd994RTF3MduJv77La2CXKEnK98depvEqWGEswbJgsf/animation.mp4",
+		AnimationURL:         "https://storage.opensea.io/files/3865c195f3ab904e60670b516eb9a0ec.mp4",
+		AssetContract: AssetContract{
+			Address:                     "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+			AssetContractType:           "semi-fungible",
+			BuyerFeeBasisPoints:         0,
+			CreatedDate:                 MustParseTimestamp("2020-05-27T16:53:32.834583"),
+			DefaultToFiat:               false,
+			Description:                 "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
+			DevBuyerFeeBasisPoints:      0,
+			DevSellerFeeBasisPoints:     0,
+			ExternalLink:                "https://rarible.com/",
+			ImageURL:                    "https://lh3.googleusercontent.com/FG0QJ00fN3c_FWuPeUr9-T__iQl63j9hn5d6svW8UqOmia5zp3lKHPkJuHcvhZ0f_Pd6P2COo9tt9zVUvdPxG_9BBw=s60",
+			Name:                        "Rarible 1155",
+			NftVersion:                  "",
+			OnlyProxiedTransfers:        false,
+			OpenseaBuyerFeeBasisPoints:  0,
+			OpenseaSellerFeeBasisPoints: 250,
+			OpenseaVersion:              "",
+			Owner:                       42603523,
+			PayoutAddress:               "",
+			SchemaName:                  "ERC1155",
+			SellerFeeBasisPoints:        250,
+			Symbol:                      "",
+			TotalSupply:                 "",
+		},
+		BackgroundColor: "",
+		Collection: Collection{
+			BannerImageURL:          "https://storage.opensea.io/static/banners/rarible-banner4.png",
+			ChatURL:                 "",
+			CreatedDate:             MustParseTimestamp("2020-01-01T13:22:57.777065"),
+			DefaultToFiat:           false,
+			Description:             "Create and sell digital collectibles secured with blockchain technology. Rarible is home to thousands of artists and collectors, creating and exchanging immutable art without using code. Trade with RARI token on OpenSea.",
+			DevBuyerFeeBasisPoints:  0,
+			DevSellerFeeBasisPoints: 0,
+			DiscordURL:              "",
+			DisplayData: CollectionDisplayData{
+				CardDisplayStyle: "contain",
+			},
+			ExternalURL:                 "https://rarible.com/",
+			Featured:                    false,
+			FeaturedImageURL:            "",
+			Hidden:                      false,
+			ImageURL:                    "https://lh3.googleusercontent.com/FG0QJ00fN3c_FWuPeUr9-T__iQl63j9hn5d6svW8UqOmia5zp3lKHPkJuHcvhZ0f_Pd6P2COo9tt9zVUvdPxG_9BBw=s60",
+			InstagramUsername:           "",
+			IsSubjectToWhitelist:        false,
+			LargeImageURL:               "https://lh3.googleusercontent.com/FG0QJ00fN3c_FWuPeUr9-T__iQl63j9hn5d6svW8UqOmia5zp3lKHPkJuHcvhZ0f_Pd6P2COo9tt9zVUvdPxG_9BBw",
+			MediumUsername:              "rarible",
+			Name:                        "Rarible",
+			OnlyProxiedTransfers:        false,
+			OpenseaBuyerFeeBasisPoints:  0,
+			OpenseaSellerFeeBasisPoints: 250,
+			PayoutAddress:               "",
+			RequireEmail:                false,
+			SafelistRequestStatus:       "approved",
+			ShortDescription:            "",
+			Slug:                        "rarible",
+			TelegramURL:                 "https://t.me/rarible",
+			TwitterUsername:             "rariblecom",
+			WikiURL:                     "",
+		},
+		Creator: Account{
+			Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+			Config:        "",
+			ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+			User: AccountUser{
+				Username: "knightsof88",
+			},
+		},
+		Decimals:          0,
+		Description:       "A visual and sonic exploration of the journey of black visionaries.",
+		ExternalLink:      "https://rarible.com/token/0xd07dc4262bcdbf85190c01c996b4c06a461d2430:681954",
+		ID:                41092356,
+		ImageOriginalURL:  "https://ipfs.io/ipfs/QmPDd994RTF3MduJv77La2CXKEnK98depvEqWGEswbJgsf/image.gif",
+		ImagePreviewURL:   "https://lh3.googleusercontent.com/uYGcbJqQqe35SQ9oz_btsxRQZq1U8FTfl46SL0ApuLcVU4kEYI505PW72IU3zWre8moCPwc7DKxOgKqadVoyW5jRm43xNeVLUSgP=s250",
+		ImageThumbnailURL: "https://lh3.googleusercontent.com/uYGcbJqQqe35SQ9oz_btsxRQZq1U8FTfl46SL0ApuLcVU4kEYI505PW72IU3zWre8moCPwc7DKxOgKqadVoyW5jRm43xNeVLUSgP=s128",
+		ImageURL:          "https://lh3.googleusercontent.com/uYGcbJqQqe35SQ9oz_btsxRQZq1U8FTfl46SL0ApuLcVU4kEYI505PW72IU3zWre8moCPwc7DKxOgKqadVoyW5jRm43xNeVLUSgP",
+		IsPresale:         false,
+		LastSale: AssetLastSale{
+			Asset: AssetLastSaleAsset{
+				TokenID:  MustParseTokenID("681954"),
+				Decimals: 0,
+			},
+			CreatedDate:    MustParseTimestamp("2022-01-12T19:26:08.123456"),
+			EventTimestamp: MustParseTimestamp("2022-01-12T19:25:31"),
+			EventType:      EventTypeSale,
+			PaymentToken: &PaymentToken{
+				Address:  "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+				Decimals: intPtr(18),
+				EthPrice: "1.000000000000000",
+				ID:       2,
+				ImageURL: "https://storage.opensea.io/files/accae6b6fb3888cbff27a013729c22dc.svg",
+				Name:     "Wrapped Ether",
+				Symbol:   "WETH",
+				UsdPrice: "3250.120000000000000000",
+			},
+			Quantity: "1",
+			Seller: &Account{
+				Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+				Config:        "",
+				ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+				User: AccountUser{
+					Username: "knightsof88",
+				},
+			},
+			TotalPrice: MustParsePrice("45000000000000000", 18),
+			Transaction: &Transaction{
+				BlockHash:   "0x2b0b5c1e0d1f3c8a4b2e6f7a9d8c1b3e5f7a9c0d2e4f6a8b0c1d3e5f7a9b0c1d",
+				BlockNumber: "13995011",
+				From: &Account{
+					Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+					User: AccountUser{
+						ID: 869843,
+					},
+				},
+				ID:        262843519,
+				Timestamp: MustParseTimestamp("2022-01-12T19:25:31"),
+				To: &Account{
+					Address:       "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+				},
+				TransactionHash:  "0x8f1a1c7e3f6b2d4a9c5e0b7d1f3a5c7e9b2d4f6a8c0e1b3d5f7a9c2e4b6d8f0a",
+				TransactionIndex: "87",
+			},
+			Winner: &Account{
+				Address:       "0x3e1d7b1e8ea5c4b9b3a9d6c0e1b8a6f0d2c4e6a8",
+				Config:        "",
+				ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/24.png",
+				User: AccountUser{
+					ID: 146242,
+				},
+			},
+		},
+		Name:     "Freedom On The Menu (Visionary)",
+		NumSales: 1,
+		Orders: []Order{
+			Order{
+				ApprovedOnChain:   false,
+				Asset:             nil,
+				BasePrice:         MustParsePrice("80000000000000000", 18),
+				BountyMultiple:    "0.01",
+				Calldata:          "0xf242432a000000000000000000000000a432cf92dcb8636cbf697f1c1c8076bb7f82f314000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a67e2000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+				Cancelled:         false,
+				ClosingExtendable: false,
+				CreatedDate:       MustParseTimestamp("2021-08-26T18:59:42.647990"),
+				CurrentBounty:     MustParsePrice("800000000000000", 18),
+				CurrentPrice:      MustParsePrice("80000000000000000", 18),
+				Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+				ExpirationTime:    UnixTimestamp(0),
+				Extra:             "0",
+				FeeMethod:         1,
+				FeeRecipient: Account{
+					Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+					Config:        "verified",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+					User: AccountUser{
+						Username: "OS-Wallet",
+					},
+				},
+				Finalized:   false,
+				HowToCall:   0,
+				ListingTime: UnixTimestamp(1630004273),
+				Maker: Account{
+					Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+					User: AccountUser{
+						Username: "",
+					},
+				},
+				MakerProtocolFee: "0",
+				MakerReferrerFee: "0",
+				MakerRelayerFee:  "250",
+				MarkedInvalid:    false,
+				Metadata: OrderMetadata{
+					Asset: OrderMetadataAsset{
+						Address:  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+						ID:       MustParseTokenID("681954"),
+						Quantity: "1",
+					},
+					Schema: "ERC1155",
+				},
+				OrderHash:    "0x30665f7d6a09eca98999a2a4ef529f3847f2495ff2d6f1f8b62d44417a44c75d",
+				PaymentToken: "0x0000000000000000000000000000000000000000",
+				PaymentTokenContract: PaymentToken{
+					Address:  "0x0000000000000000000000000000000000000000",
+					Decimals: intPtr(18),
+					EthPrice: "1.000000000000000",
+					ID:       1,
+					ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+					Name:     "Ether",
+					Symbol:   "ETH",
+					UsdPrice: "2454.469999999999800000",
+				},
+				PrefixedHash:       "0xc4aee32f1a2ca3ccbb2ee832acf479bcc952990e7b52c0df5092eff45ab4aaef",
+				Quantity:           "1",
+				R:                  "0x5a473b96b01b84e04987426e05d3f380ffa231b41de4f0b349b8b8e8f457871b",
+				ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+				S:                  "0x7f481d13466b77c513fe23519abb3012d807764c4bafb73ca45a5fc5f9690b05",
+				SaleKind:           0,
+				Salt:               "37807066599228839761082381987094645843543820864275911452860088185447967723074",
+				Side:               1,
+				StaticExtradata:    "0x",
+				StaticTarget:       "0x0000000000000000000000000000000000000000",
+				Taker: Account{
+					Address:       "0x0000000000000000000000000000000000000000",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+					User: AccountUser{
+						Username: "",
+					},
+				},
+				TakerProtocolFee: "0",
+				TakerRelayerFee:  "0",
+				Target:           "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+				V:                27,
+			},
+		},
+		Owner: Account{
+			Address:       "0x0000000000000000000000000000000000000000",
+			Config:        "",
+			ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+			User: AccountUser{
+				Username: "NullAddress",
+			},
+		},
+		Ownership: &AssetOwnership{
+			CreatedDate: MustParseTimestamp("2022-01-20T10:02:33.000001"),
+			Owner: Account{
+				Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
+				Config:        "",
+				ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
+				User: AccountUser{
+					Username: "",
+				},
+			},
+			Quantity: "1",
+		},
+		Permalink: "https://opensea.io/assets/0xd07dc4262bcdbf85190c01c996b4c06a461d2430/681954",
+		SellOrders: []Order{
+			Order{
+				ApprovedOnChain:   false,
+				BasePrice:         MustParsePrice("80000000000000000", 18),
+				BountyMultiple:    "0.01",
+				Calldata:          "0xf242432a000000000000000000000000a432cf92dcb8636cbf697f1c1c8076bb7f82f314000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a67e2000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000",
+				Cancelled:         false,
+				ClosingExtendable: false,
+				CreatedDate:       MustParseTimestamp("2021-08-26T18:59:42.647990"),
+				CurrentBounty:     MustParsePrice("800000000000000", 18),
+				CurrentPrice:      MustParsePrice("80000000000000000", 18),
+				Exchange:          "0x7be8076f4ea4a4ad08075c2508e481d6c946d12b",
+				ExpirationTime:    UnixTimestamp(0),
+				Extra:             "0",
+				FeeMethod:         1,
+				FeeRecipient: Account{
+					Address:       "0x5b3256965e7c3cf26e11fcaf296dfc8807c01073",
+					Config:        "verified",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/28.png",
+					User: AccountUser{
+						ID: 3585,
+					},
+				},
+				Finalized:   false,
+				HowToCall:   0,
+				ListingTime: UnixTimestamp(1630004273),
+				Maker: Account{
+					Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+					User: AccountUser{
+						ID: 433742,
+					},
+				},
+				MakerProtocolFee: "0",
+				MakerReferrerFee: "0",
+				MakerRelayerFee:  "250",
+				MarkedInvalid:    false,
+				Metadata: OrderMetadata{
+					Asset: OrderMetadataAsset{
+						Address:  "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+						ID:       MustParseTokenID("681954"),
+						Quantity: "1",
+					},
+					Schema: "ERC1155",
+				},
+				OrderHash:    "0x30665f7d6a09eca98999a2a4ef529f3847f2495ff2d6f1f8b62d44417a44c75d",
+				PaymentToken: "0x0000000000000000000000000000000000000000",
+				PaymentTokenContract: PaymentToken{
+					Address:  "0x0000000000000000000000000000000000000000",
+					Decimals: intPtr(18),
+					EthPrice: "1.000000000000000",
+					ID:       1,
+					ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg",
+					Name:     "Ether",
+					Symbol:   "ETH",
+					UsdPrice: "2454.469999999999800000",
+				},
+				PrefixedHash:       "0xc4aee32f1a2ca3ccbb2ee832acf479bcc952990e7b52c0df5092eff45ab4aaef",
+				Quantity:           "1",
+				R:                  "0x5a473b96b01b84e04987426e05d3f380ffa231b41de4f0b349b8b8e8f457871b",
+				ReplacementPattern: "0x000000000000000000000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+				S:                  "0x7f481d13466b77c513fe23519abb3012d807764c4bafb73ca45a5fc5f9690b05",
+				SaleKind:           0,
+				Salt:               "37807066599228839761082381987094645843543820864275911452860088185447967723074",
+				Side:               1,
+				StaticExtradata:    "0x",
+				StaticTarget:       "0x0000000000000000000000000000000000000000",
+				Taker: Account{
+					Address:       "0x0000000000000000000000000000000000000000",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+					User: AccountUser{
+						ID: 1766,
+					},
+				},
+				TakerProtocolFee: "0",
+				TakerRelayerFee:  "0",
+				Target:           "0xd07dc4262bcdbf85190c01c996b4c06a461d2430",
+				V:                27,
+			},
+		},
+		SupportsWyvern: true,
+		TokenID:        MustParseTokenID("681954"),
+		TokenMetadata:  "https://ipfs.io/ipfs/QmazchMpr9jeeZYcECtn9NM6cFCHYo8eQz4PTt5D9kN85m",
+		TopBid:         "",
+		TopOwnerships: []AssetOwnership{
+			AssetOwnership{
+				CreatedDate: MustParseTimestamp("2021-08-26T18:55:01.123456"),
+				Owner: Account{
+					Address:       "0xa432cf92dcb8636cbf697f1c1c8076bb7f82f314",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/13.png",
+					User: AccountUser{
+						Username: "nftmag",
+					},
+				},
+				Quantity: "3",
+			},
+			AssetOwnership{
+				CreatedDate: MustParseTimestamp("2022-01-20T10:02:33.000001"),
+				Owner: Account{
+					Address:       "0x3b417faee9d2ff636701100891dc2755b5321cc3",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
+					User: AccountUser{
+						Username: "",
+					},
+				},
+				Quantity: "1",
+			},
+		},
+		TransferFee:             "",
+		TransferFeePaymentToken: "",
+	}
+	FixtureGetCollectionsResp = []Collection{
+		Collection{
+			Editors: []string{
+				"0x0239769a1adf4def9f07da824b80b9c4fcb59593",
+				"0xe96a1b303a1eb8d04fb973eb2b291b8d591c8f72",
+				"0xffb36e12031d07b4fe71c21cd7a01e29089d86d4",
+				"0x76107bff7d4bcd85548a2889ec9f94815b7937f7",
+				"0x931d24f3dede331bfd02a1aa855e9cbf7f0ea2e7",
+				"0x4ac2d3391d39e5af436f17594ac7d00d992bef9e",
+				"0xc2740ea77fa9d3cc47c4cda7825a6be96b3c192d",
+				"0x7c1fd4d5acc15b46729c5fb9279b49cf71178654",
+				"0x4ff00e6cf23158ccc9e7a6b9a504cd37e5bc0a93",
+				"0xe7e4ae2f7087358f278b705038d14a4ae296bf9e",
+				"0x10e328cf4245501cde8b3825d34b605c5f6a436b",
+				"0xa84618338ee02ec398f6b841c7a888f28f9ae9d6",
+				"0xd2dfa218b2b30f8b7ce5ccb3142b8429c9d33e7d",
+				"0xa972551724754e05bed000e2bda663cecd3b6b80",
+				"0xf8624fab50ade6831abc6b3162e9d405792dcfd1",
+				"0x1d131f70dfae405dcdd8538f2eaae514d4710613",
+				"0xca04d584fc1395acf7405d9323d57abcb1a38afc",
+				"0xa5964a40b6b1dbb48b1613af3efbb947310d06ff",
+				"0x958e1e0be167e89eb05eb81e5ac57d260eb9ba03",
+				"0xb8c1fece2c2928b1e985f59e8546c2f0c13296d4",
+				"0x53353e9867e34a48ff5d586dd5f07bc1da3fdf75",
+				"0xb1109aad77b16d7af2b4e710b83d958927293cce",
+				"0x9e0f9d58497242e09354279886e05b24b1585738",
+				"0x2580e799f91b96b54370b493852e4475badd3449",
+				"0x28679a1a632125fbbf7a68d850e50623194a709e",
+				"0x5e98629b10d2537988ff63ebb57df7378e08e373",
+				"0x836fd05a18dc13aa8885a718278e6a316ecca1ff",
+				"0xd144054f09d17175d66aaa9ee73d7931c627fa9a",
+				"0xba207a26d53f673cf71cbd2e71d3d5153210c3e4",
+				"0xf7e886215cd82e9cacf1b1d2cd7ca5cc01d6488e",
+				"0xf05633fae0f200064b12b2dfa9b17fbccd91fd81",
+				"0xb9720be63ea8896956a06d2ded491de125fd705e",
+				"0x841b57a4e2590d6affb167686f343cb97675a426",
+				"0x0bde34d7a38c7414db64dafba0fe492f60083188",
+				"0xe948d683cc5e94498f84396f1f80de842d18acaf",
+				"0x7c3e32b9da9a5ab46c8f715d4ab51e1a74a197ed",
+				"0xf2a469a4150925ff9f82c3728dd6d02dbe55371c",
+				"0x7bd13f8c9bc23d7caff3cb5248c8ba63226df8a1",
+				"0x0ac374554e8075ac2cccc2b4307adefd827e0137",
+				"0xce89b8a4db53246456d6794b63ff4b0995f5f25e",
+				"0xb8ef554b53968a29fd6b83e70b08e75ea32001ce",
+				"0xaba7161a7fb69c88e16ed9f455ce62b791ee4d03",
+				"0xd7383c3c94a1e2f1224ec54b06db9fae5352d375",
+				"0xeaf54391793cc80de696d72713d7518c6190bfe0",
+				"0x333601a803cac32b7d17a38d32c9728a93b422f4",
+				"0x92b381515bd4851faf3d33a161f7967fd87b1227",
+				"0x700fe545742485732575a7245f558978adcc1ec4",
+				"0x82f4f1f00ebffa2dda53ae633e720e5ac62e1c88",
+				"0x50088f08381e2a04c6c485dab0eb03561a011c41",
+				"0x4884eb76842f734e0de54d79ad45266072eb68e2",
+				"0x889d43a6f9c2e48b913559a1333ead19e6444499",
+				"0x0aff57bf099a72ee482051d7ebc9786c38e1bf93",
+				"0xfdb16a768c4d41d7c0e1e5f5ce1d3c8234f6db0a",
+				"0xa76ed27cdaa1d1f964111bf56dd4a7d4b7c5f7ed",
+				"0xa4a1a4fc58bf49da4e83d8cf8861570901a17679",
+				"0xe6518b10420d5f657b8eb637e2c622cab4be6e66",
+				"0x710ea68f78103398c5c998ba69d63d26435d7421",
+				"0xf40ec41d6ca845e6f3abcba98878217b8f551d63",
+				"0x095b5d221ed6ebcdda14e479ba2e6e8933cb66fd",
+				"0x10ee35231ff8f7ab5489cfb29648387b5e64a87b",
+				"0x54fd7a70976c0477b422d3b444fef2601ce60f57",
+				"0xf982cee77b0b78eb6b6e1f7565c465de99f1d2ee",
+				"0x7817615d7a814b0c13c1dcda5de218f164860135",
+				"0x36791c410e271d381b0957dac16c667d3c467a83",
+				"0x54498b8af97672b544f759297de9139ce5f5312c",
+				"0x97c4d1090416e9e0661a9d7688b3932cbf323c5f",
+				"0x3ed0ad7f35eb40e6ea939404b1cfbe5254e217f6",
+				"0xd0b00382c7ee6d6f8a7ef1fda07750c1d5fa68cb",
+				"0x84bee312e24de23581388f85fd3a09b9f18b5093",
+				"0xc8fe620d5a7b5591bc0cb083d84e296937a1f63e",
+				"0xeeadc5701b5fd25fbcc7fbf04b048cdb1fff1c65",
+				"0x3e275ddf0b02dc4626deba238d175295064c0efe",
+				"0xd1f93089d9004004f8e30c54de5a584902962ce8",
+				"0x530cf036ed4fa58f7301a9c788c9806624cefd19",
+				"0x53969c1464763c7af8a9b9759c5253bf453490dc",
+				"0xd9d52f157f4ea47f52639e8bf606775d18bea317",
+				"0xd43809d61e5f42debc180e71c3c1acf3f621a312",
+				"0x4f0124d010a29a81855ab72c59447b6186153250",
+				"0x0359c0b2b30ff5e52893156c1baba2b55a468700",
+				"0x04a190d66f638bd6f1c997c00a91c5b3a523d4a3",
+				"0x8eaf5461e19fced3c47e024cbff5b3bb55adbb0e",
+				"0x75479b52c8ccbd74716fb3ea17074aaef14c66a2",
+				"0x5370eb1675b9ce1d6492f59b4376e4bf67105bb8",
+				"0x175c1319fe37d8b28a208daac7b41a6e413c1c75",
+				"0x6fb94110aad7d1dbe711fc80febb552bb9f52a25",
+				"0x9f6ac750a020d3141838cbf35b444fd5b732ec7d",
+			},
+			PaymentTokens: []PaymentToken{
+				{ID: 4645681, Symbol: "WETH", Address: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", ImageURL: "https://storage.opensea.io/files/accae6b6fb3888cbff27a013729c22dc.svg", Name: "Wrapped Ether", Decimals: intPtr(18), EthPrice: "1", UsdPrice: "2617.42"},
+				{ID: 4403908, Symbol: "USDC", Address: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", ImageURL: "https://storage.opensea.io/files/749015f009a66abcb3bbb3502ae2f1ce.svg", Name: "USD Coin", Decimals: intPtr(6), EthPrice: "0.00038164", UsdPrice: "1"},
+				{ID: 13689077, Symbol: "ETH", Address: "0x0000000000000000000000000000000000000000", ImageURL: "https://storage.opensea.io/files/6f8e2979d428180222796ff4a33ab929.svg", Name: "Ether", Decimals: intPtr(18), EthPrice: "1", UsdPrice: "2617.42"},
+				{ID: 12182941, Symbol: "DAI", Address: "0x6b175474e89094c44da98b954eedeac495271d0f", ImageURL: "https://storage.opensea.io/files/8ef8fb3fe707f693e57cdbfea130c24c.svg", Name: "Dai Stablecoin", Decimals: intPtr(18), EthPrice: "0.00038193", UsdPrice: "1"},
+			},
+			PrimaryAssetContracts: []AssetContract{
+				{Address: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", AssetContractType: "non-fungible", CreatedDate: MustParseTimestamp("2021-04-22T03:03:43.731860"), Name: "BoredApeYachtClub", NftVersion: "3.0", OpenseaVersion: "", Owner: 34522873, SchemaName: "ERC721", Symbol: "BAYC", TotalSupply: "0", Description: "The Bored Ape Yacht Club is a collection of 10,000 unique Bored Ape NFTs— unique digital collectibles living on the Ethereum blockchain. Your Bored Ape doubles as your Yacht Club membership card, and grants access to members-only benefits, the first of which is access to THE BATHROOM, a collaborative graffiti board. Future areas and perks can be unlocked by the community through roadmap activation. Visit www.BoredApeYachtClub.com for more details.", ExternalLink: "http://www.boredapeyachtclub.com/", ImageURL: "https://lh3.googleusercontent.com/Ju9CkWtV-1Okvf45wo8UctR-M9He2PjILP0oOvxE89AyiPPGtrR3gysu1Zgy0hjd2xKIgjJJtWIc0ybj4Vd7wv8t3pxDGHoJBzDB=s120", DefaultToFiat: false, DevBuyerFeeBasisPoints: 0, DevSellerFeeBasisPoints: 250, OnlyProxiedTransfers: false, OpenseaBuyerFeeBasisPoints: 0, OpenseaSellerFeeBasisPoints: 250, BuyerFeeBasisPoints: 0, SellerFeeBasisPoints: 500, PayoutAddress: "0xaae7ac476b117bccafe2f05f582906be44bc8ff1"},
+			},
+			Stats:                       CollectionStats{OneDayVolume: 7220.968899999999, OneDayChange: 0.5058357695612996, OneDaySales: 57, OneDayAveragePrice: 126.68366491228068, SevenDayVolume: 28085.562700000006, SevenDayChange: 1.3760080647805017, SevenDaySales: 269, SevenDayAveragePrice: 104.40729628252791, ThirtyDayVolume: 83957.6926970619, ThirtyDayChange: 1.3326166299956324, ThirtyDaySales: 915, ThirtyDayAveragePrice: 91.75704119897475, TotalVolume: 364773.71301449905, TotalSales: 24373, TotalSupply: 10000, Count: 10000, NumOwners: 6223, AveragePrice: 14.966303410105406, NumReports: 27, MarketCap: 1.044072962825279e+06, FloorPrice: 106.9},
+			BannerImageURL:              "https://lh3.googleusercontent.com/i5dYZRkVCUK97bfprQ3WXyrT9BnLSZtVKGJlKQ919uaUB0sxbngVCioaiyu9r6snqfi2aaTyIvv6DHm4m2R3y7hMajbsv14pSZK8mhs=s2500",
+			ChatURL:                     "",
+			CreatedDate:                 MustParseTimestamp("2021-04-22T23:14:03.967121"),
+			DefaultToFiat:               false,
+			Description:                 "The Bored Ape Yacht Club is a collection of 10,000 unique Bored Ape NFTs— unique digital collectibles living on the Ethereum blockchain. Your Bored Ape doubles as your Yacht Club membership card, and grants access to members-only benefits, the first of which is access to THE BATHROOM, a collaborative graffiti board. Future areas and perks can be unlocked by the community through roadmap activation. Visit www.BoredApeYachtClub.com for more details.",
+			DevBuyerFeeBasisPoints:      0,
+			DevSellerFeeBasisPoints:     250,
+			DiscordURL:                  "https://discord.gg/3P5K3dzgdB",
+			DisplayData:                 CollectionDisplayData{CardDisplayStyle: "contain"},
+			ExternalURL:                 "http://www.boredapeyachtclub.com/",
+			Featured:                    false,
+			FeaturedImageURL:            "https://lh3.googleusercontent.com/RBX3jwgykdaQO3rjTcKNf5OVwdukKO46oOAV3zZeiaMb8VER6cKxPDTdGZQdfWcDou75A8KtVZWM_fEnHG4d4q6Um8MeZIlw79BpWPA=s300",
+			Hidden:                      false,
+			SafelistRequestStatus:       "verified",
+			ImageURL:                    "https://lh3.googleusercontent.com/Ju9CkWtV-1Okvf45wo8UctR-M9He2PjILP0oOvxE89AyiPPGtrR3gysu1Zgy0hjd2xKIgjJJtWIc0ybj4Vd7wv8t3pxDGHoJBzDB=s120",
+			IsSubjectToWhitelist:        false,
+			LargeImageURL:               "https://lh3.googleusercontent.com/RBX3jwgykdaQO3rjTcKNf5OVwdukKO46oOAV3zZeiaMb8VER6cKxPDTdGZQdfWcDou75A8KtVZWM_fEnHG4d4q6Um8MeZIlw79BpWPA=s300",
+			MediumUsername:              "",
+			Name:                        "Bored Ape Yacht Club",
+			OnlyProxiedTransfers:        false,
+			OpenseaBuyerFeeBasisPoints:  0,
+			OpenseaSellerFeeBasisPoints: 250,
+			PayoutAddress:               "0xaae7ac476b117bccafe2f05f582906be44bc8ff1",
+			RequireEmail:                false,
+			ShortDescription:            "",
+			Slug:                        "boredapeyachtclub",
+			TelegramURL:                 "",
+			TwitterUsername:             "BoredApeYC",
+			InstagramUsername:           "boredapeyachtclub",
+			WikiURL:                     "",
+			OwnedAssetCount:             2,
+		},
+		Collection{
+			BannerImageURL:          "https://lh3.googleusercontent.com/WGO25nS0pXt_TqIDiFity0wDKerZ0bfo1Qow75oUBGnYv3dYZITHa33bEAEw8FkpGgldeA7CR93uD4qWv9mdEvB_rWj8K21EOteMpA=s2500",
+			ChatURL:                 "",
+			CreatedDate:             MustParseTimestamp("2021-12-07T04:59:27.767502"),
+			DefaultToFiat:           false,
+			Description:             "DAO Balance: 1,024.65 ETH | Last Updated 1/20/22\n\n----\n\nIlluminatiNFT is a collection of 8,128 generative NFTs. 50% of the initial mint and secondary royalties go into the [Illuminati Collective DAO](https://etherscan.io/address/0xa43653fdab0c0967ab8f9cd7d84b3205a9315b03), a governance DAO for the community\n\nEach IlluminatiNFT doubles as a vote for activations and experiences paid for by the Illuminati Collective DAO and grants exclusive access into our [community](https://discord.com/invite/illuminati)\n\n----\n\nWe are the stern prescient, the unrepentant present who enter the secret and serpentine nests of KNOWLEDGE and pursue the tenets of the TRUTH\n\nWe are the knowing unknown. We are those who REMAIN\n\nAfter centuries of ritual, calculation, sacrifice, and research, we present to you few:\nThe Illuminati Non-Fungible Token—the COUNTERSIGN for a secret society on the blockchain\n\nIf you wish to see the TRUTH, if you wish to take your place in the CIRCLE, you must be brave enough to look",
+			DevBuyerFeeBasisPoints:  0,
+			DevSellerFeeBasisPoints: 500,
+			DiscordURL:              "https://discord.gg/illuminati",
+			DisplayData: CollectionDisplayData{
+				CardDisplayStyle: "contain",
+			},
+			ExternalURL:                 "https://www.illuminatinft.com/",
+			Featured:                    false,
+			FeaturedImageURL:            "https://lh3.googleusercontent.com/o8DpANVTZM3zwMMv6ePv8JMfWAoVlCxFoUT0rcuLYZNoMs8Dn-4v6Y4vkm24rm-R_B_BFkTgZ6cHNiv45BpRpcZSzr3f83BpS5tqktw=s300",
+			Hidden:                      false,
+			ImageURL:                    "https://lh3.googleusercontent.com/V7sJyL6PYZKLlJlb7QG8oZcFvCzBYm2BqUiSoIL8Z2miupOPR5VdAMQqkf3Nq-lf763efqzxu5QiItQmASQrJrSUqOWU89Z7LJvW=s120",
+			InstagramUsername:           "",
+			IsSubjectToWhitelist:        false,
+			LargeImageURL:               "https://lh3.googleusercontent.com/o8DpANVTZM3zwMMv6ePv8JMfWAoVlCxFoUT0rcuLYZNoMs8Dn-4v6Y4vkm24rm-R_B_BFkTgZ6cHNiv45BpRpcZSzr3f83BpS5tqktw=s300",
+			MediumUsername:              "",
+			Name:                        "IlluminatiNFT",
+			OnlyProxiedTransfers:        false,
+			OpenseaBuyerFeeBasisPoints:  0,
+			OpenseaSellerFeeBasisPoints: 250,
+			PayoutAddress:               "0x0aa1f3d61e7c325ae795737266c5fd6839819b86",
+			RequireEmail:                false,
+			SafelistRequestStatus:       "approved",
+			ShortDescription:            "",
+			Slug:                        "illuminaticollective",
+			TelegramURL:                 "",
+			TwitterUsername:             "truth",
+			WikiURL:                     "",
+			OwnedAssetCount:             1,
+		},
+	}
+
+	FixtureGetAssetContractResp = AssetContract{
+		Address:                     "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+		AssetContractType:           "non-fungible",
+		CreatedDate:                 MustParseTimestamp("2021-04-22T03:03:43.731860"),
+		Name:                        "BoredApeYachtClub",
+		NftVersion:                  "3.0",
+		OpenseaVersion:              "",
+		Owner:                       34522873,
+		SchemaName:                  "ERC721",
+		Symbol:                      "BAYC",
+		TotalSupply:                 "0",
+		Description:                 "The Bored Ape Yacht Club is a collection of 10,000 unique Bored Ape NFTs— unique digital collectibles living on the Ethereum blockchain. Your Bored Ape doubles as your Yacht Club membership card, and grants access to members-only benefits, the first of which is access to THE BATHROOM, a collaborative graffiti board. Future areas and perks can be unlocked by the community through roadmap activation. Visit www.BoredApeYachtClub.com for more details.",
+		ExternalLink:                "http://www.boredapeyachtclub.com/",
+		ImageURL:                    "https://lh3.googleusercontent.com/Ju9CkWtV-1Okvf45wo8UctR-M9He2PjILP0oOvxE89AyiPPGtrR3gysu1Zgy0hjd2xKIgjJJtWIc0ybj4Vd7wv8t3pxDGHoJBzDB=s120",
+		DefaultToFiat:               false,
+		DevBuyerFeeBasisPoints:      0,
+		DevSellerFeeBasisPoints:     250,
+		OnlyProxiedTransfers:        false,
+		OpenseaBuyerFeeBasisPoints:  0,
+		OpenseaSellerFeeBasisPoints: 250,
+		BuyerFeeBasisPoints:         0,
+		SellerFeeBasisPoints:        500,
+		PayoutAddress:               "0xaae7ac476b117bccafe2f05f582906be44bc8ff1",
+		Collection:                  &FixtureGetCollectionResp,
+	}
+
+	FixtureGetEventsResp = GetEventsResponse{
+		Next: "LWV2ZW50X3RpbWVzdGFtcD0yMDIyLTAz",
+		AssetEvents: []Event{
+			{
+				ID: 6134572531,
+				Asset: &Asset{
+					ID:        17473466,
+					TokenID:   MustParseTokenID("7090"),
+					ImageURL:  "https://lh3.googleusercontent.com/7090.png",
+					Permalink: "https://opensea.io/assets/0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d/7090",
+				},
+				CollectionSlug:  "boredapeyachtclub",
+				ContractAddress: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+				CreatedDate:     MustParseTimestamp("2022-03-20T18:42:07.437514"),
+				EventTimestamp:  MustParseTimestamp("2022-03-20T18:41:51"),
+				EventType:       EventTypeSale,
+				Quantity:        "1",
+				Transaction: &Transaction{
+					BlockHash:   "0x7d5e8a8d6e0b2f2f0c4b6a0c0b9b6a9f3b1b5e1a2c2d3e4f5a6b7c8d9e0f1a2b",
+					BlockNumber: "14422135",
+					From: &Account{
+						Address:       "0x6f4a2d3a4f47f9c647d86c929755593911ee91ec",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
+						User:          AccountUser{Username: "winner"},
+					},
+					ID:        296113823,
+					Timestamp: MustParseTimestamp("2022-03-20T18:41:51"),
+					To: &Account{
+						Address:       "0x7f268357a8c2552623316e2562d90e642bb538e5",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/3.png",
+					},
+					TransactionHash:  "0x2c6b3bbe7d4d5fbb3c13f3c5a0a6f7fa2a5a77a5d4e2b5e4cbd6e4e9d1a0c3b2",
+					TransactionIndex: "120",
+				},
+				Sale: &SaleEvent{
+					PaymentToken: &PaymentToken{
+						Address:  "0x0000000000000000000000000000000000000000",
+						Decimals: intPtr(18),
+						EthPrice: "1.000000000000000",
+						ID:       1,
+						ImageURL: "https://openseauserdata.com/files/6f8e2979d428180222796ff4a33ab929.svg",
+						Name:     "Ether",
+						Symbol:   "ETH",
+						UsdPrice: "2931.309999999999945000",
+					},
+					Seller: &Account{
+						Address:       "0x8d4ec4e62d3c6e2e6cbaa0ed3fd6b7bc6eae29d2",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+						User:          AccountUser{Username: "seller"},
+					},
+					TotalPrice: MustParsePrice("105000000000000000000", 18),
+					Winner: &Account{
+						Address:       "0x6f4a2d3a4f47f9c647d86c929755593911ee91ec",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
+						User:          AccountUser{Username: "winner"},
+					},
+				},
+			},
+			{
+				ID:              6134561012,
+				CollectionSlug:  "boredapeyachtclub",
+				ContractAddress: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+				CreatedDate:     MustParseTimestamp("2022-03-20T18:40:02.118392"),
+				EventTimestamp:  MustParseTimestamp("2022-03-20T18:40:02.118392"),
+				EventType:       EventTypeListing,
+				Quantity:        "1",
+				Listing: &ListingEvent{
+					AuctionType: "dutch",
+					EndingPrice: MustParsePrice("98000000000000000000", 18),
+					Seller: &Account{
+						Address:       "0x8d4ec4e62d3c6e2e6cbaa0ed3fd6b7bc6eae29d2",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+						User:          AccountUser{Username: "seller"},
+					},
+					StartingPrice: MustParsePrice("98000000000000000000", 18),
+				},
+			},
+			{
+				ID:              6134550498,
+				CollectionSlug:  "boredapeyachtclub",
+				ContractAddress: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+				CreatedDate:     MustParseTimestamp("2022-03-20T18:38:44.902113"),
+				EventTimestamp:  MustParseTimestamp("2022-03-20T18:38:44.902113"),
+				EventType:       EventTypeBid,
+				Quantity:        "1",
+				Bid: &BidEvent{
+					BidAmount: MustParsePrice("90000000000000000000", 18),
+					From: &Account{
+						Address:       "0x6f4a2d3a4f47f9c647d86c929755593911ee91ec",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/2.png",
+						User:          AccountUser{Username: "winner"},
+					},
+					PaymentToken: &PaymentToken{
+						Address:  "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+						Decimals: intPtr(18),
+						EthPrice: "1.000000000000000",
+						ID:       2,
+						ImageURL: "https://openseauserdata.com/files/accae6b6fb3888cbff27a013729c22dc.svg",
+						Name:     "Wrapped Ether",
+						Symbol:   "WETH",
+						UsdPrice: "2931.309999999999945000",
+					},
+				},
+			},
+			{
+				ID:              6134533117,
+				CollectionSlug:  "boredapeyachtclub",
+				ContractAddress: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+				CreatedDate:     MustParseTimestamp("2022-03-20T18:35:10.550712"),
+				EventTimestamp:  MustParseTimestamp("2022-03-20T18:34:58"),
+				EventType:       EventTypeTransfer,
+				Quantity:        "1",
+				Transaction: &Transaction{
+					BlockHash:        "0x1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b",
+					BlockNumber:      "14422101",
+					ID:               296110072,
+					Timestamp:        MustParseTimestamp("2022-03-20T18:34:58"),
+					TransactionHash:  "0x9f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a39281706f5e4d3c2b1a0",
+					TransactionIndex: "87",
+				},
+				Transfer: &TransferEvent{
+					From: &Account{
+						Address:       "0x0000000000000000000000000000000000000000",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/4.png",
+						User:          AccountUser{Username: "NullAddress"},
+					},
+					To: &Account{
+						Address:       "0x8d4ec4e62d3c6e2e6cbaa0ed3fd6b7bc6eae29d2",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+						User:          AccountUser{Username: "seller"},
+					},
+				},
+			},
+			{
+				ID:              6134520002,
+				CollectionSlug:  "boredapeyachtclub",
+				ContractAddress: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+				CreatedDate:     MustParseTimestamp("2022-03-20T18:30:00.000001"),
+				EventTimestamp:  MustParseTimestamp("2022-03-20T18:30:00"),
+				EventType:       EventTypeApproval,
+				Quantity:        "1",
+				Approval: &ApprovalEvent{
+					Approved: &Account{
+						Address: "0x1e0049783f008a0085193e00003d00cd54003c71",
+					},
+					Owner: &Account{
+						Address:       "0x8d4ec4e62d3c6e2e6cbaa0ed3fd6b7bc6eae29d2",
+						ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+						User:          AccountUser{Username: "seller"},
+					},
+				},
+			},
+		},
+	}
+
+	FixtureGetListingsResp = GetSeaportOrdersResponse{
+		Next: "cj0xJnA9MjAyMi0wOS0wMSsxMiUzQTAwJTNBMDAuMDAwMDAw",
+		Orders: []SeaportOrder{
+			SeaportOrder{
+				CreatedDate:    MustParseTimestamp("2022-09-01T12:00:00.123456"),
+				ClosingDate:    MustParseTimestamp("2022-09-08T12:00:00"),
+				ListingTime:    UnixTimestamp(1662033600),
+				ExpirationTime: UnixTimestamp(1662638400),
+				OrderHash:      "0x8c1c7a1b4b2e9f1e3d6f0a9c2b7e4d5a6f8c9b0a1d2e3f4a5b6c7d8e9f0a1b2c",
+				ProtocolData: SeaportProtocolData{
+					Parameters: SeaportOrderParameters{
+						Offerer: "0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+						Offer: []SeaportItem{
+							SeaportItem{
+								ItemType:             ItemTypeERC721,
+								Token:                "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+								IdentifierOrCriteria: MustParseTokenID("7090"),
+								StartAmount:          MustParsePrice("1", 0),
+								EndAmount:            MustParsePrice("1", 0),
+							},
+						},
+						Consideration: []SeaportConsiderationItem{
+							SeaportConsiderationItem{
+								SeaportItem: SeaportItem{
+									ItemType:             ItemTypeNative,
+									Token:                "0x0000000000000000000000000000000000000000",
+									IdentifierOrCriteria: MustParseTokenID("0"),
+									StartAmount:          MustParsePrice("85025000000000000000", 18),
+									EndAmount:            MustParsePrice("85025000000000000000", 18),
+								},
+								Recipient: "0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+							},
+							SeaportConsiderationItem{
+								SeaportItem: SeaportItem{
+									ItemType:             ItemTypeNative,
+									Token:                "0x0000000000000000000000000000000000000000",
+									IdentifierOrCriteria: MustParseTokenID("0"),
+									StartAmount:          MustParsePrice("2237500000000000000", 18),
+									EndAmount:            MustParsePrice("2237500000000000000", 18),
+								},
+								Recipient: "0x0000a26b00c1f0df003000390027140000faa719",
+							},
+							SeaportConsiderationItem{
+								SeaportItem: SeaportItem{
+									ItemType:             ItemTypeNative,
+									Token:                "0x0000000000000000000000000000000000000000",
+									IdentifierOrCriteria: MustParseTokenID("0"),
+									StartAmount:          MustParsePrice("2237500000000000000", 18),
+									EndAmount:            MustParsePrice("2237500000000000000", 18),
+								},
+								Recipient: "0xa858ddc0445d8131dac4d1de01f834ffcba52ef1",
+							},
+						},
+						StartTime:                       MustParseTimestamp("1662033600"),
+						EndTime:                         MustParseTimestamp("1662638400"),
+						OrderType:                       OrderTypeFullRestricted,
+						Zone:                            "0x004c00500000ad104d7dbd00e3ae0a5c00560c00",
+						ZoneHash:                        "0x0000000000000000000000000000000000000000000000000000000000000000",
+						Salt:                            "0x360c6ebe0000000000000000000000000000000000000000a1b2c3d4e5f60718",
+						ConduitKey:                      "0x0000007b02230091a7ed01230072f7006a004d60a8d4e71d599b8104250f0000",
+						TotalOriginalConsiderationItems: 3,
+						Counter:                         "0",
+					},
+					Signature: "0x5b2c0e7a9f3d1c4b6e8a0d2f4c6e8a1b3d5f7a9c1e3b5d7f9a2c4e6b8d0f2a4c6e8b1d3f5a7c9e1b3d5f7a9c2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a5c71b",
+				},
+				ProtocolAddress: "0x00000000006c3852cbef3e08e8df289169ede581",
+				Maker: &Account{
+					Address:       "0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+					Config:        "",
+					ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/1.png",
+					User: AccountUser{
+						ID: 1666865,
+					},
+				},
+				CurrentPrice: MustParsePrice("89500000000000000000", 18),
+				MakerFees: []SeaportFee{
+					SeaportFee{
+						Account: Account{
+							Address:       "0x0000a26b00c1f0df003000390027140000faa719",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/29.png",
+						},
+						BasisPoints: 250,
+					},
+					SeaportFee{
+						Account: Account{
+							Address:       "0xa858ddc0445d8131dac4d1de01f834ffcba52ef1",
+							Config:        "",
+							ProfileImgURL: "https://storage.googleapis.com/opensea-static/opensea-profile/31.png",
+						},
+						BasisPoints: 250,
+					},
+				},
+				TakerFees:       []SeaportFee{},
+				Side:            OrderSideAsk,
+				OrderType:       "basic",
+				Cancelled:       false,
+				Finalized:       false,
+				MarkedInvalid:   false,
+				ClientSignature: "0x5b2c0e7a9f3d1c4b6e8a0d2f4c6e8a1b3d5f7a9c1e3b5d7f9a2c4e6b8d0f2a4c6e8b1d3f5a7c9e1b3d5f7a9c2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a5c71b",
+				RelayID:         "T3JkZXJWMlR5cGU6MTIzNDU2Nzg5",
+			},
+		},
+	}
 )
@@ -0,0 +1,136 @@
+package opensea
+
+import "context"
+
+// AssetIterator yields the assets of a search one at a time, fetching pages
+// as they are needed.
+//
+//	it := c.IterateAssets(ctx, opensea.AssetsQuery{Owner: owner})
+//	defer it.Close()
+//	for it.Next() {
+//		asset := it.Asset()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		// Resume later with AssetsQuery{Owner: owner, Cursor: it.Cursor()}.
+//	}
+type AssetIterator struct {
+	ctx    context.Context
+	p      *AssetsPaginator
+	page   []Asset
+	pos    int
+	asset  Asset
+	cursor string
+	offset int
+	err    error
+	closed bool
+}
+
+// IterateAssets returns an iterator over the assets matching query.
+func (c *OpenSeaClient) IterateAssets(ctx context.Context, query AssetsQuery) *AssetIterator {
+	return &AssetIterator{
+		ctx:    ctx,
+		p:      c.NewAssetsPaginator(query),
+		cursor: query.Cursor,
+		offset: query.Offset,
+	}
+}
+
+// Next advances to the next asset. It returns false when there are no more
+// assets, an error occurred or the iterator was closed.
+func (it *AssetIterator) Next() bool {
+	for it.pos >= len(it.page) {
+		if it.err != nil || it.closed || !it.p.HasNextPage() {
+			return false
+		}
+
+		it.cursor, it.offset = it.p.Cursor(), it.p.Offset()
+		resp, err := it.p.NextPage(it.ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.pos = resp.Assets, 0
+	}
+
+	it.asset = it.page[it.pos]
+	it.pos++
+	return true
+}
+
+// Asset returns the current asset.
+func (it *AssetIterator) Asset() Asset {
+	return it.asset
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *AssetIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the cursor of the page holding the current asset, or of the
+// page that failed to load after an error. Setting it as AssetsQuery.Cursor
+// restarts the walk from that page, so up to a page of assets may be seen
+// twice. It is empty for the first page and in PaginationOffset mode.
+func (it *AssetIterator) Cursor() string {
+	return it.cursor
+}
+
+// Offset is like Cursor but returns the offset of the page in
+// PaginationOffset mode.
+func (it *AssetIterator) Offset() int {
+	return it.offset
+}
+
+// Filtered returns the number of assets dropped by the filters of the
+// client on the pages fetched so far.
+func (it *AssetIterator) Filtered() FilterCounts {
+	return it.p.Filtered()
+}
+
+// Close stops the iteration. Next returns false afterwards.
+func (it *AssetIterator) Close() {
+	it.closed = true
+	it.page = nil
+}
+
+// AssetResult is an item sent by StreamAssets. Either Asset or Err is set.
+type AssetResult struct {
+	Asset Asset
+	// Cursor is the cursor of the page holding Asset, see AssetIterator.Cursor.
+	Cursor string
+	// Filtered is the number of assets dropped by the filters of the client
+	// on the pages fetched so far, see AssetIterator.Filtered.
+	Filtered FilterCounts
+	Err      error
+}
+
+// StreamAssets sends the assets matching query on the returned channel,
+// which is closed after the last asset or after an error, sent as a result
+// with Err set. Cancel ctx to stop the stream early.
+func (c *OpenSeaClient) StreamAssets(ctx context.Context, query AssetsQuery) <-chan AssetResult {
+	results := make(chan AssetResult, c.limitAssets)
+
+	go func() {
+		defer close(results)
+
+		it := c.IterateAssets(ctx, query)
+		defer it.Close()
+
+		for it.Next() {
+			select {
+			case results <- AssetResult{Asset: it.Asset(), Cursor: it.Cursor(), Filtered: it.Filtered().clone()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			select {
+			case results <- AssetResult{Cursor: it.Cursor(), Filtered: it.Filtered().clone(), Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return results
+}
@@ -0,0 +1,141 @@
+package opensea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+// newCursorServer serves three pages of assets linked by cursors. The page
+// at failCursor responds with a server error until fail is cleared.
+func newCursorServer(t *testing.T, failCursor string, fail *bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		if cursor == failCursor && *fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch cursor {
+		case "":
+			w.Write([]byte(`{"assets": [{"id": 1}, {"id": 2}], "next": "page2"}`))
+		case "page2":
+			w.Write([]byte(`{"assets": [{"id": 3}, {"id": 4}], "next": "page3", "previous": "page1"}`))
+		case "page3":
+			w.Write([]byte(`{"assets": [{"id": 5}], "next": null, "previous": "page2"}`))
+		default:
+			t.Errorf("Unexpected cursor %s", cursor)
+		}
+	}))
+}
+
+func TestAssetIterator(t *testing.T) {
+	fail := true
+	server := newCursorServer(t, "page3", &fail)
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 2,
+	}
+
+	var (
+		ids     []int
+		cursors []string
+	)
+	it := c.IterateAssets(context.Background(), AssetsQuery{Collection: "boredapeyachtclub"})
+	for it.Next() {
+		ids = append(ids, it.Asset().ID)
+		cursors = append(cursors, it.Cursor())
+	}
+	if !hasStatus(it.Err(), http.StatusInternalServerError) {
+		t.Fatalf("AssetIterator.Err() = %v, want a server error", it.Err())
+	}
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("iterated %v, want %v", ids, want)
+	}
+	if want := []string{"", "", "page2", "page2"}; !reflect.DeepEqual(cursors, want) {
+		t.Errorf("cursors %q, want %q", cursors, want)
+	}
+	if it.Cursor() != "page3" {
+		t.Fatalf("AssetIterator.Cursor() = %s after error, want page3", it.Cursor())
+	}
+
+	// Resume from the failed page.
+	fail = false
+	ids = nil
+	it = c.IterateAssets(context.Background(), AssetsQuery{Collection: "boredapeyachtclub", Cursor: it.Cursor()})
+	for it.Next() {
+		ids = append(ids, it.Asset().ID)
+	}
+	if it.Err() != nil {
+		t.Fatalf("AssetIterator.Err() = %v", it.Err())
+	}
+	if want := []int{5}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("iterated %v after resuming, want %v", ids, want)
+	}
+}
+
+func TestAssetIterator_Close(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"assets": [{"id": 1}, {"id": 2}], "next": "more"}`))
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 2,
+	}
+	it := c.IterateAssets(context.Background(), AssetsQuery{})
+	if !it.Next() {
+		t.Fatalf("AssetIterator.Next() = false, want true")
+	}
+	it.Close()
+	if it.Next() {
+		t.Errorf("AssetIterator.Next() = true after Close")
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1", requests)
+	}
+}
+
+func TestOpenSeaClient_StreamAssets(t *testing.T) {
+	fail := false
+	server := newCursorServer(t, "", &fail)
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 2,
+	}
+
+	var ids []int
+	for res := range c.StreamAssets(context.Background(), AssetsQuery{}) {
+		if res.Err != nil {
+			t.Fatalf("StreamAssets() error = %v", res.Err)
+		}
+		ids = append(ids, res.Asset.ID)
+	}
+	if want := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("streamed %v, want %v", ids, want)
+	}
+
+	// Stop after the first asset.
+	ctx, cancel := context.WithCancel(context.Background())
+	results := c.StreamAssets(ctx, AssetsQuery{})
+	<-results
+	cancel()
+	for range results {
+	}
+}
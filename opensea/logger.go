@@ -0,0 +1,122 @@
+package opensea
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// Logger is the logging interface used by OpenSeaClient.
+// *zap.SugaredLogger satisfies it directly.
+type Logger interface {
+	Debugf(template string, args ...interface{})
+	Infof(template string, args ...interface{})
+	Warnf(template string, args ...interface{})
+	Errorf(template string, args ...interface{})
+}
+
+// StructuredLogger is a leveled logger taking a message and key-value pairs,
+// like *slog.Logger.
+type StructuredLogger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// LogLevel is the level at which an event is logged.
+type LogLevel int
+
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+	// LevelOff disables logging of an event.
+	LevelOff
+)
+
+// LogEvent identifies a kind of event logged by the client.
+type LogEvent int
+
+const (
+	// EventRequest is logged before every HTTP request.
+	EventRequest LogEvent = iota
+	// EventRetry is logged before a failed request is retried.
+	EventRetry
+	// EventError is logged when a method returns an error.
+	EventError
+)
+
+// defaultLogLevels are the levels used for events without a configured level.
+var defaultLogLevels = map[LogEvent]LogLevel{
+	EventRequest: LevelDebug,
+	EventRetry:   LevelWarn,
+	EventError:   LevelError,
+}
+
+// NewZapLogger returns a Logger writing to a zap logger.
+func NewZapLogger(l *zap.Logger) Logger {
+	return l.Sugar()
+}
+
+// NewStructuredLogger returns a Logger writing formatted messages to a
+// structured logger such as *slog.Logger.
+func NewStructuredLogger(l StructuredLogger) Logger {
+	return structuredLogger{l}
+}
+
+type structuredLogger struct {
+	l StructuredLogger
+}
+
+func (s structuredLogger) Debugf(template string, args ...interface{}) {
+	s.l.Debug(fmt.Sprintf(template, args...))
+}
+
+func (s structuredLogger) Infof(template string, args ...interface{}) {
+	s.l.Info(fmt.Sprintf(template, args...))
+}
+
+func (s structuredLogger) Warnf(template string, args ...interface{}) {
+	s.l.Warn(fmt.Sprintf(template, args...))
+}
+
+func (s structuredLogger) Errorf(template string, args ...interface{}) {
+	s.l.Error(fmt.Sprintf(template, args...))
+}
+
+// NewNopLogger returns a Logger that discards everything.
+func NewNopLogger() Logger {
+	return nopLogger{}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debugf(string, ...interface{}) {}
+func (nopLogger) Infof(string, ...interface{})  {}
+func (nopLogger) Warnf(string, ...interface{})  {}
+func (nopLogger) Errorf(string, ...interface{}) {}
+
+// logf logs an event at its configured level.
+func (c *OpenSeaClient) logf(event LogEvent, template string, args ...interface{}) {
+	if c.Log == nil {
+		return
+	}
+
+	level, ok := c.logLevels[event]
+	if !ok {
+		level = defaultLogLevels[event]
+	}
+
+	switch level {
+	case LevelDebug:
+		c.Log.Debugf(template, args...)
+	case LevelInfo:
+		c.Log.Infof(template, args...)
+	case LevelWarn:
+		c.Log.Warnf(template, args...)
+	case LevelError:
+		c.Log.Errorf(template, args...)
+	}
+}
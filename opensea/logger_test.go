@@ -0,0 +1,100 @@
+package opensea
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// recordingLogger records log lines prefixed with their level.
+type recordingLogger struct {
+	lines []string
+}
+
+func (r *recordingLogger) Debugf(template string, args ...interface{}) {
+	r.lines = append(r.lines, "debug: "+fmt.Sprintf(template, args...))
+}
+
+func (r *recordingLogger) Infof(template string, args ...interface{}) {
+	r.lines = append(r.lines, "info: "+fmt.Sprintf(template, args...))
+}
+
+func (r *recordingLogger) Warnf(template string, args ...interface{}) {
+	r.lines = append(r.lines, "warn: "+fmt.Sprintf(template, args...))
+}
+
+func (r *recordingLogger) Errorf(template string, args ...interface{}) {
+	r.lines = append(r.lines, "error: "+fmt.Sprintf(template, args...))
+}
+
+func (r *recordingLogger) Debug(msg string, args ...interface{}) { r.Debugf("%s %v", msg, args) }
+func (r *recordingLogger) Info(msg string, args ...interface{})  { r.Infof("%s %v", msg, args) }
+func (r *recordingLogger) Warn(msg string, args ...interface{})  { r.Warnf("%s %v", msg, args) }
+func (r *recordingLogger) Error(msg string, args ...interface{}) { r.Errorf("%s %v", msg, args) }
+
+func TestOpenSeaClient_LogLevels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		opts []Option
+		want []string
+	}{
+		{
+			name: "Default levels",
+			want: []string{
+				"debug: GET " + server.URL + "/api/v1/collection/missing",
+				"error: Error getting collection: opensea: " + server.URL + "/api/v1/collection/missing: 404 Not Found",
+			},
+		},
+		{
+			name: "Without error logging",
+			opts: []Option{WithoutErrorLogging()},
+			want: []string{
+				"debug: GET " + server.URL + "/api/v1/collection/missing",
+			},
+		},
+		{
+			name: "Custom levels",
+			opts: []Option{WithLogLevel(EventRequest, LevelInfo), WithLogLevel(EventError, LevelWarn)},
+			want: []string{
+				"info: GET " + server.URL + "/api/v1/collection/missing",
+				"warn: Error getting collection: opensea: " + server.URL + "/api/v1/collection/missing: 404 Not Found",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &recordingLogger{}
+			opts := append([]Option{WithLogger(logger), WithBaseURL(server.URL)}, tt.opts...)
+			c, err := NewOpenSeaClient("", opts...)
+			if err != nil {
+				t.Fatalf("NewOpenSeaClient() error = %v", err)
+			}
+
+			if _, err := c.GetCollection("missing"); !IsNotFound(err) {
+				t.Errorf("OpenSeaClient.GetCollection() error = %v, want not found", err)
+			}
+			if !reflect.DeepEqual(logger.lines, tt.want) {
+				t.Errorf("logged %q, want %q", logger.lines, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewStructuredLogger(t *testing.T) {
+	r := &recordingLogger{}
+	l := NewStructuredLogger(r)
+	l.Infof("fetched %d assets", 3)
+	l.Errorf("failed: %s", "boom")
+
+	want := []string{"info: fetched 3 assets []", "error: failed: boom []"}
+	if !reflect.DeepEqual(r.lines, want) {
+		t.Errorf("logged %q, want %q", r.lines, want)
+	}
+}
@@ -1,6 +1,7 @@
 package opensea
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"time"
@@ -10,56 +11,153 @@ import (
 
 // OpenSeaClient represents the client for the OpenSea API.
 type OpenSeaClient struct {
-	Log *zap.SugaredLogger
+	Log   Logger
+	Retry RetryPolicy
 
-	apiKey       string
-	client       *http.Client
-	baseURL      string
-	limitAssets  int
-	requestDelay time.Duration
+	// Limiter throttles every request made by the client. It may be shared
+	// with other clients using the same API key.
+	Limiter *RateLimiter
+	// EndpointLimiters throttles requests whose URL path starts with the
+	// given prefix, e.g. "/api/v1/assets", in addition to Limiter. When
+	// several prefixes match, the longest one is used.
+	EndpointLimiters map[string]*RateLimiter
+
+	apiKey      string
+	client      *http.Client
+	baseURL     string
+	limitAssets int
+	pagination  PaginationMode
+	userAgent   string
+	logLevels   map[LogEvent]LogLevel
+	filters     []Filter
+	// sleep waits between retries; nil uses sleepContext.
+	sleep func(ctx context.Context, d time.Duration) error
 }
 
 // NewOpenSeaClient creates a new OpenSea client with configuration.
-func NewOpenSeaClient(apiKey string) *OpenSeaClient {
-	logger, err := zap.NewProduction()
-	if err != nil {
-		panic(err)
-	}
-
-	return &OpenSeaClient{
-		Log: logger.Sugar(),
+// The defaults can be changed with opts.
+func NewOpenSeaClient(apiKey string, opts ...Option) (*OpenSeaClient, error) {
+	c := &OpenSeaClient{
+		Retry:   DefaultRetryPolicy(),
+		Limiter: NewRateLimiter(4, 1),
 
 		apiKey:  apiKey,
 		baseURL: "https://api.opensea.io",
 		client: &http.Client{
 			Timeout: time.Second * 10,
 		},
-		limitAssets:  50,
-		requestDelay: time.Millisecond * 250,
+		limitAssets: 50,
+		userAgent:   "go-opensea",
 	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Log == nil {
+		logger, err := zap.NewProduction()
+		if err != nil {
+			return nil, err
+		}
+		c.Log = logger.Sugar()
+	}
+
+	return c, nil
 }
 
-// NewRequest creates a new request and adds authentication headers.
+// GetRequest creates a new request and adds authentication headers.
 func (c *OpenSeaClient) GetRequest(u *url.URL) (*http.Request, error) {
+	return c.GetRequestContext(context.Background(), u)
+}
+
+// GetRequestContext creates a new request bound to ctx and adds authentication headers.
+func (c *OpenSeaClient) GetRequestContext(ctx context.Context, u *url.URL) (*http.Request, error) {
 	var err error
 
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("X-API-KEY", c.apiKey)
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 
 	return req, nil
 }
 
 // Get does a GET request.
 func (c *OpenSeaClient) Get(u *url.URL) (*http.Response, error) {
-	req, err := c.GetRequest(u)
+	return c.GetContext(context.Background(), u)
+}
+
+// GetContext does a GET request bound to ctx. Responses with a non-2xx status
+// code are returned as an *APIError. Failed requests are retried according
+// to c.Retry.
+func (c *OpenSeaClient) GetContext(ctx context.Context, u *url.URL) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := c.do(ctx, u)
+		if err == nil {
+			return resp, nil
+		}
+
+		delay, ok := c.Retry.retryDelay(attempt, err)
+		if !ok {
+			return nil, err
+		}
+
+		c.logf(EventRetry, "Retrying %s in %s (attempt %d of %d): %s", u, delay, attempt+1, c.Retry.MaxAttempts, err)
+		sleep := c.sleep
+		if sleep == nil {
+			sleep = sleepContext
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do performs a single rate limited GET request.
+func (c *OpenSeaClient) do(ctx context.Context, u *url.URL) (*http.Response, error) {
+	if err := c.waitRateLimit(ctx, u.Path); err != nil {
+		return nil, err
+	}
+
+	req, err := c.GetRequestContext(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logf(EventRequest, "GET %s", u)
+	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.client.Do(req)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newAPIError(resp)
+	}
+
+	return resp, nil
+}
+
+// sleepContext pauses for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
 }
@@ -0,0 +1,177 @@
+package opensea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// maxPageSize is the largest page size accepted by the OpenSea API.
+const maxPageSize = 50
+
+// Option configures an OpenSeaClient created by NewOpenSeaClient.
+type Option func(*OpenSeaClient) error
+
+// WithBaseURL sets the base URL of the API, e.g. for a testnet or a mirror.
+func WithBaseURL(baseURL string) Option {
+	return func(c *OpenSeaClient) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("opensea: invalid base URL %q: %w", baseURL, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("opensea: invalid base URL %q: must be an absolute http(s) URL", baseURL)
+		}
+		c.baseURL = strings.TrimRight(baseURL, "/")
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to make requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *OpenSeaClient) error {
+		if client == nil {
+			return fmt.Errorf("opensea: HTTP client must not be nil")
+		}
+		c.client = client
+		return nil
+	}
+}
+
+// WithLogger sets the logger of the client. Use NewNopLogger to disable
+// logging entirely.
+func WithLogger(logger Logger) Option {
+	return func(c *OpenSeaClient) error {
+		if logger == nil {
+			return fmt.Errorf("opensea: logger must not be nil")
+		}
+		c.Log = logger
+		return nil
+	}
+}
+
+// WithLogLevel sets the level at which event is logged.
+func WithLogLevel(event LogEvent, level LogLevel) Option {
+	return func(c *OpenSeaClient) error {
+		if level < LevelDebug || level > LevelOff {
+			return fmt.Errorf("opensea: invalid log level %d", level)
+		}
+		if c.logLevels == nil {
+			c.logLevels = make(map[LogEvent]LogLevel)
+		}
+		c.logLevels[event] = level
+		return nil
+	}
+}
+
+// WithoutErrorLogging stops the client from logging errors it returns, for
+// callers that log them themselves.
+func WithoutErrorLogging() Option {
+	return WithLogLevel(EventError, LevelOff)
+}
+
+// WithPageSize sets the number of items requested per page.
+func WithPageSize(size int) Option {
+	return func(c *OpenSeaClient) error {
+		if size < 1 || size > maxPageSize {
+			return fmt.Errorf("opensea: invalid page size %d: must be between 1 and %d", size, maxPageSize)
+		}
+		c.limitAssets = size
+		return nil
+	}
+}
+
+// WithPaginationMode sets how paginated endpoints are walked. The default is
+// PaginationCursor.
+func WithPaginationMode(mode PaginationMode) Option {
+	return func(c *OpenSeaClient) error {
+		if mode != PaginationCursor && mode != PaginationOffset {
+			return fmt.Errorf("opensea: invalid pagination mode %d", mode)
+		}
+		c.pagination = mode
+		return nil
+	}
+}
+
+// WithRequestDelay limits the client to one request every delay. A zero
+// delay disables client-wide rate limiting.
+func WithRequestDelay(delay time.Duration) Option {
+	return func(c *OpenSeaClient) error {
+		if delay < 0 {
+			return fmt.Errorf("opensea: invalid request delay %s: must not be negative", delay)
+		}
+		if delay == 0 {
+			c.Limiter = nil
+			return nil
+		}
+		c.Limiter = NewRateLimiter(float64(time.Second)/float64(delay), 1)
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *OpenSeaClient) error {
+		if userAgent == "" {
+			return fmt.Errorf("opensea: user agent must not be empty")
+		}
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithFilters adds filters dropping assets, orders and events from
+// responses, applied in order. See Filter.
+func WithFilters(filters ...Filter) Option {
+	return func(c *OpenSeaClient) error {
+		for _, f := range filters {
+			if f.Name == "" || f.Drop == nil {
+				return fmt.Errorf("opensea: invalid filter %q: name and predicate must be set", f.Name)
+			}
+		}
+		c.filters = append(c.filters, filters...)
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *OpenSeaClient) error {
+		if p.MaxAttempts < 0 || p.BaseBackoff < 0 || p.MaxBackoff < 0 || p.MaxRetryAfter < 0 {
+			return fmt.Errorf("opensea: invalid retry policy: attempts and backoffs must not be negative")
+		}
+		if p.Jitter < 0 || p.Jitter > 1 {
+			return fmt.Errorf("opensea: invalid retry policy: jitter %f must be between 0 and 1", p.Jitter)
+		}
+		c.Retry = p
+		return nil
+	}
+}
+
+// WithRateLimiter sets the client-wide rate limiter. Passing the same
+// limiter to several clients makes them share its quota.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(c *OpenSeaClient) error {
+		c.Limiter = l
+		return nil
+	}
+}
+
+// WithEndpointRateLimiter throttles requests whose URL path starts with prefix.
+func WithEndpointRateLimiter(prefix string, l *RateLimiter) Option {
+	return func(c *OpenSeaClient) error {
+		if !strings.HasPrefix(prefix, "/") {
+			return fmt.Errorf("opensea: invalid endpoint prefix %q: must start with /", prefix)
+		}
+		if l == nil {
+			return fmt.Errorf("opensea: rate limiter for %q must not be nil", prefix)
+		}
+		if c.EndpointLimiters == nil {
+			c.EndpointLimiters = make(map[string]*RateLimiter)
+		}
+		c.EndpointLimiters[prefix] = l
+		return nil
+	}
+}
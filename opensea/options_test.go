@@ -0,0 +1,110 @@
+package opensea
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestNewOpenSeaClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "my-crawler/1.0" {
+			t.Errorf("Expected User-Agent: my-crawler/1.0 header, got: %s", got)
+		}
+		if got := r.Header.Get("X-API-KEY"); got != "secret" {
+			t.Errorf("Expected X-API-KEY: secret header, got: %s", got)
+		}
+		w.Write([]byte(`{"collection": {"slug": "boredapeyachtclub"}}`))
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Timeout: time.Second}
+	shared := NewRateLimiter(10, 5)
+	c, err := NewOpenSeaClient("secret",
+		WithBaseURL(server.URL+"/"),
+		WithHTTPClient(httpClient),
+		WithLogger(zaptest.NewLogger(t).Sugar()),
+		WithPageSize(20),
+		WithUserAgent("my-crawler/1.0"),
+		WithRateLimiter(shared),
+		WithEndpointRateLimiter("/api/v1/assets", NewRateLimiter(1, 1)),
+	)
+	if err != nil {
+		t.Fatalf("NewOpenSeaClient() error = %v", err)
+	}
+	if c.baseURL != server.URL {
+		t.Errorf("baseURL = %s, want %s", c.baseURL, server.URL)
+	}
+	if c.client != httpClient {
+		t.Errorf("client was not set")
+	}
+	if c.limitAssets != 20 {
+		t.Errorf("limitAssets = %d, want 20", c.limitAssets)
+	}
+	if c.Limiter != shared {
+		t.Errorf("Limiter was not set")
+	}
+	if len(c.EndpointLimiters) != 1 {
+		t.Errorf("EndpointLimiters = %v, want one limiter", c.EndpointLimiters)
+	}
+
+	got, err := c.GetCollection("boredapeyachtclub")
+	if err != nil {
+		t.Fatalf("OpenSeaClient.GetCollection() error = %v", err)
+	}
+	if got.Slug != "boredapeyachtclub" {
+		t.Errorf("OpenSeaClient.GetCollection() slug = %s, want boredapeyachtclub", got.Slug)
+	}
+}
+
+func TestNewOpenSeaClient_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{"Relative base URL", WithBaseURL("api.opensea.io")},
+		{"Unsupported scheme", WithBaseURL("ftp://api.opensea.io")},
+		{"Nil HTTP client", WithHTTPClient(nil)},
+		{"Nil logger", WithLogger(nil)},
+		{"Zero page size", WithPageSize(0)},
+		{"Page size too large", WithPageSize(51)},
+		{"Unknown pagination mode", WithPaginationMode(PaginationMode(5))},
+		{"Negative request delay", WithRequestDelay(-time.Second)},
+		{"Empty user agent", WithUserAgent("")},
+		{"Negative retry attempts", WithRetryPolicy(RetryPolicy{MaxAttempts: -1})},
+		{"Jitter out of range", WithRetryPolicy(RetryPolicy{Jitter: 2})},
+		{"Relative endpoint prefix", WithEndpointRateLimiter("api/v1/assets", NewRateLimiter(1, 1))},
+		{"Nil endpoint limiter", WithEndpointRateLimiter("/api/v1/assets", nil)},
+		{"Filter without predicate", WithFilters(Filter{Name: "custom"})},
+		{"Filter without name", WithFilters(NewFilter("", func(Asset) bool { return false }))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewOpenSeaClient("", tt.opt)
+			if err == nil {
+				t.Errorf("NewOpenSeaClient() = %v, want an error", c)
+			}
+		})
+	}
+}
+
+func TestWithRequestDelay(t *testing.T) {
+	c, err := NewOpenSeaClient("", WithLogger(zaptest.NewLogger(t).Sugar()), WithRequestDelay(time.Millisecond*500))
+	if err != nil {
+		t.Fatalf("NewOpenSeaClient() error = %v", err)
+	}
+	if c.Limiter == nil || c.Limiter.rate != 2 || c.Limiter.burst != 1 {
+		t.Errorf("Limiter = %+v, want 2 rps with burst 1", c.Limiter)
+	}
+
+	c, err = NewOpenSeaClient("", WithLogger(zaptest.NewLogger(t).Sugar()), WithRequestDelay(0))
+	if err != nil {
+		t.Fatalf("NewOpenSeaClient() error = %v", err)
+	}
+	if c.Limiter != nil {
+		t.Errorf("Limiter = %+v, want nil", c.Limiter)
+	}
+}
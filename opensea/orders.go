@@ -1,23 +1,137 @@
 package opensea
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 )
 
-func (c *OpenSeaClient) GetCheapestOrders(contract_addr string, token_id string, side string) (map[string]interface{}, error) {
-	var osResp map[string]interface{}
+// Order represents a Wyvern order on OpenSea.
+// https://docs.opensea.io/reference/orders
+type Order struct {
+	ApprovedOnChain      bool          `json:"approved_on_chain"`
+	Asset                *Asset        `json:"asset"`
+	BasePrice            Price         `json:"base_price"`
+	BountyMultiple       string        `json:"bounty_multiple"`
+	Calldata             string        `json:"calldata"`
+	Cancelled            bool          `json:"cancelled"`
+	ClosingDate          Timestamp     `json:"closing_date"`
+	ClosingExtendable    bool          `json:"closing_extendable"`
+	CreatedDate          Timestamp     `json:"created_date"`
+	CurrentBounty        Price         `json:"current_bounty"`
+	CurrentPrice         Price         `json:"current_price"`
+	Exchange             Address       `json:"exchange"`
+	ExpirationTime       Timestamp     `json:"expiration_time"`
+	Extra                string        `json:"extra"`
+	FeeMethod            int           `json:"fee_method"`
+	FeeRecipient         Account       `json:"fee_recipient"`
+	Finalized            bool          `json:"finalized"`
+	HowToCall            int           `json:"how_to_call"`
+	ListingTime          Timestamp     `json:"listing_time"`
+	Maker                Account       `json:"maker"`
+	MakerProtocolFee     string        `json:"maker_protocol_fee"`
+	MakerReferrerFee     string        `json:"maker_referrer_fee"`
+	MakerRelayerFee      string        `json:"maker_relayer_fee"`
+	MarkedInvalid        bool          `json:"marked_invalid"`
+	Metadata             OrderMetadata `json:"metadata"`
+	OrderHash            string        `json:"order_hash"`
+	PaymentToken         Address       `json:"payment_token"`
+	PaymentTokenContract PaymentToken  `json:"payment_token_contract"`
+	PrefixedHash         string        `json:"prefixed_hash"`
+	Quantity             string        `json:"quantity"`
+	R                    string        `json:"r"`
+	ReplacementPattern   string        `json:"replacement_pattern"`
+	S                    string        `json:"s"`
+	SaleKind             int           `json:"sale_kind"`
+	Salt                 string        `json:"salt"`
+	Side                 int           `json:"side"`
+	StaticExtradata      string        `json:"static_extradata"`
+	StaticTarget         Address       `json:"static_target"`
+	Taker                Account       `json:"taker"`
+	TakerProtocolFee     string        `json:"taker_protocol_fee"`
+	TakerRelayerFee      string        `json:"taker_relayer_fee"`
+	Target               Address       `json:"target"`
+	V                    int           `json:"v"`
+}
+
+// order has the fields of Order without its methods.
+type order Order
+
+// UnmarshalJSON implements json.Unmarshaler. The prices of the order take
+// the decimals of its payment token.
+func (o *Order) UnmarshalJSON(data []byte) error {
+	var v order
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Order(v)
+	o.BasePrice.withDecimals(&o.PaymentTokenContract)
+	o.CurrentBounty.withDecimals(&o.PaymentTokenContract)
+	o.CurrentPrice.withDecimals(&o.PaymentTokenContract)
+	return nil
+}
+
+// Deprecated: Use Account.
+type OrderAccount = Account
+
+type OrderMetadata struct {
+	Asset  OrderMetadataAsset `json:"asset"`
+	Schema string             `json:"schema"`
+}
+
+type OrderMetadataAsset struct {
+	Address  Address `json:"address"`
+	ID       TokenID `json:"id"`
+	Quantity string  `json:"quantity"`
+}
+
+// Deprecated: Use PaymentToken.
+type OrderPaymentToken = PaymentToken
+
+type GetOrdersResponse struct {
+	Count  int     `json:"count"`
+	Orders []Order `json:"orders"`
+
+	// Filtered counts the orders dropped by the filters of the client. Count
+	// is the total reported by OpenSea and includes them.
+	Filtered FilterCounts `json:"-"`
+}
+
+// GetCheapestOrders returns the orders for a token sorted by ascending ETH price.
+// https://docs.opensea.io/reference/retrieving-orders
+//
+// Deprecated: OpenSea retired the Wyvern orders endpoint. Use GetListings
+// with SeaportOrderByEthPrice instead.
+func (c *OpenSeaClient) GetCheapestOrders(contract_addr Address, token_id TokenID, side string) (GetOrdersResponse, error) {
+	return c.GetCheapestOrdersContext(context.Background(), contract_addr, token_id, side)
+}
+
+// GetCheapestOrdersContext is like GetCheapestOrders but bound to ctx.
+//
+// Deprecated: Use GetListingsContext.
+func (c *OpenSeaClient) GetCheapestOrdersContext(ctx context.Context, contract_addr Address, token_id TokenID, side string) (GetOrdersResponse, error) {
+	var osResp GetOrdersResponse
+	if err := validateAddressParam("asset_contract_address", contract_addr); err != nil {
+		c.logf(EventError, "Error validating contract: %s", err)
+		return osResp, err
+	}
+	if !token_id.IsSet() {
+		err := &ValidationError{Field: "token_id", Message: "must be set"}
+		c.logf(EventError, "Error validating token ID: %s", err)
+		return osResp, err
+	}
+
 	u, err := url.Parse(fmt.Sprintf("%s/wyvern/v1/orders", c.baseURL))
 	if err != nil {
-		c.Log.Errorf("Error parsing url: %s", err)
+		c.logf(EventError, "Error parsing url: %s", err)
 		return osResp, err
 	}
 
 	// Set query params
 	q := u.Query()
-	q.Set("asset_contract_address", contract_addr)
-	q.Set("token_id", token_id)
+	q.Set("asset_contract_address", contract_addr.String())
+	q.Set("token_id", token_id.String())
 	q.Set("side", side)
 	q.Set("bundled", "false")
 	q.Set("include_bundled", "false")
@@ -25,26 +139,20 @@ func (c *OpenSeaClient) GetCheapestOrders(contract_addr string, token_id string,
 	q.Set("order_direction", "asc")
 	u.RawQuery = q.Encode()
 
-	resp, err := c.Get(u)
+	resp, err := c.GetContext(ctx, u)
 	if err != nil {
-		c.Log.Errorf("Error getting Orders: %s", err)
+		c.logf(EventError, "Error getting Orders: %s", err)
 		return osResp, err
 	}
 	defer resp.Body.Close()
 
 	err = json.NewDecoder(resp.Body).Decode(&osResp)
 	if err != nil {
-		c.Log.Errorf("Error decoding response: %s", err)
+		c.logf(EventError, "Error decoding response: %s", err)
 		return osResp, err
 	}
 
-	// TODO: Filter out Orders with hidden collections
-	// var filtered = []Order{}
-	// for _, Order := range osResp.Orders {
-	// 	if !Order.Collection.Hidden {
-	// 		filtered = append(filtered, Order)
-	// 	}
-	// }
+	osResp.Orders, osResp.Filtered = c.filterOrders(osResp.Orders)
 
 	return osResp, nil
 }
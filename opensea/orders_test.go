@@ -0,0 +1,102 @@
+package opensea
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOpenSeaClient_GetCheapestOrders(t *testing.T) {
+	type fields struct {
+		Log         Logger
+		apiKey      string
+		client      *http.Client
+		baseURL     string
+		limitAssets int
+		Limiter     *RateLimiter
+	}
+	type args struct {
+		contractAddr Address
+		tokenID      TokenID
+		side         string
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		path        string
+		fixturePath string
+		want        GetOrdersResponse
+		wantErr     bool
+	}{
+		{
+			name: "Get cheapest orders",
+			fields: fields{
+				Log:         zaptest.NewLogger(t).Sugar(),
+				apiKey:      "",
+				client:      &http.Client{},
+				baseURL:     "https://api.opensea.io",
+				limitAssets: 50,
+				Limiter:     NewRateLimiter(4, 1),
+			},
+			args: args{
+				contractAddr: "0x495f947276749ce646f68ac8c248420045cb7b5e",
+				tokenID:      MustParseTokenID("49597200392958280165177755798765298064312831948909620000388784438348531893124"),
+				side:         "1",
+			},
+			path:        "/wyvern/v1/orders",
+			fixturePath: "../testdata/get_orders.json",
+			want:        FixtureGetOrdersResp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("Expected to request '%s', got: %s", tt.path, r.URL.Path)
+				}
+				if r.Header.Get("Accept") != "application/json" {
+					t.Errorf("Expected Accept: application/json header, got: %s", r.Header.Get("Accept"))
+				}
+				w.WriteHeader(http.StatusOK)
+
+				// Read the fixture
+				jsonFile, err := os.Open(tt.fixturePath)
+				if err != nil {
+					t.Errorf("Failed to open fixture file: %s", err)
+				}
+				defer jsonFile.Close()
+
+				// Write the fixture to the response
+				jsonFile.Seek(0, 0)
+				_, err = io.Copy(w, jsonFile)
+				if err != nil {
+					t.Errorf("Failed to write fixture to response: %s", err)
+				}
+			}))
+			defer server.Close()
+
+			c := &OpenSeaClient{
+				Log:         tt.fields.Log,
+				apiKey:      tt.fields.apiKey,
+				client:      tt.fields.client,
+				baseURL:     server.URL,
+				Limiter:     tt.fields.Limiter,
+				limitAssets: tt.fields.limitAssets,
+			}
+			got, err := c.GetCheapestOrders(tt.args.contractAddr, tt.args.tokenID, tt.args.side)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OpenSeaClient.GetCheapestOrders() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OpenSeaClient.GetCheapestOrders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package opensea
+
+import "context"
+
+// PaginationMode selects how paginated endpoints are walked.
+type PaginationMode int
+
+const (
+	// PaginationCursor follows the next cursor returned with every page.
+	// This is the only mode supported by the current OpenSea API beyond the
+	// first 10,000 items.
+	PaginationCursor PaginationMode = iota
+	// PaginationOffset increments the offset by the page size until an
+	// empty page is returned. It is meant for mirrors of the API that do not
+	// return cursors.
+	PaginationOffset
+)
+
+// pageState tracks the position of a paginated walk.
+type pageState struct {
+	mode     PaginationMode
+	pageSize int
+	offset   int
+	cursor   string
+	done     bool
+}
+
+// advance moves past a page holding n items and linking to the next cursor.
+func (s *pageState) advance(n int, next string) {
+	switch s.mode {
+	case PaginationOffset:
+		if n == 0 {
+			s.done = true
+		}
+		s.offset += s.pageSize
+	default:
+		if next == "" {
+			s.done = true
+		}
+		s.offset = 0
+		s.cursor = next
+	}
+}
+
+// AssetsPaginator walks the pages of an assets search.
+type AssetsPaginator struct {
+	client   *OpenSeaClient
+	query    AssetsQuery
+	state    pageState
+	filtered FilterCounts
+}
+
+// NewAssetsPaginator returns a paginator over the assets matching query,
+// starting at query.Cursor or query.Offset.
+func (c *OpenSeaClient) NewAssetsPaginator(query AssetsQuery) *AssetsPaginator {
+	pageSize := query.Limit
+	if pageSize == 0 {
+		pageSize = c.limitAssets
+	}
+
+	return &AssetsPaginator{
+		client: c,
+		query:  query,
+		state: pageState{
+			mode:     c.pagination,
+			pageSize: pageSize,
+			offset:   query.Offset,
+			cursor:   query.Cursor,
+		},
+	}
+}
+
+// HasNextPage reports whether there are more pages to fetch.
+func (p *AssetsPaginator) HasNextPage() bool {
+	return !p.state.done
+}
+
+// Cursor returns the cursor of the next page. It is empty before the first
+// page and in PaginationOffset mode.
+func (p *AssetsPaginator) Cursor() string {
+	return p.state.cursor
+}
+
+// Offset returns the offset of the next page in PaginationOffset mode.
+func (p *AssetsPaginator) Offset() int {
+	return p.state.offset
+}
+
+// NextPage fetches the next page. On error the paginator stays on the same
+// page, so calling NextPage again retries it.
+func (p *AssetsPaginator) NextPage(ctx context.Context) (GetAssetsResponse, error) {
+	if p.state.done {
+		return GetAssetsResponse{}, nil
+	}
+
+	p.query.Offset, p.query.Cursor = p.state.offset, p.state.cursor
+	resp, err := p.client.SearchAssetsContext(ctx, p.query)
+	if err != nil {
+		return resp, err
+	}
+
+	// A page emptied by the filters does not end offset pagination.
+	p.state.advance(len(resp.Assets)+resp.Filtered.Total(), resp.Next)
+	p.filtered = p.filtered.merge(resp.Filtered)
+	return resp, nil
+}
+
+// Filtered returns the number of assets dropped by the filters of the
+// client on the pages fetched so far.
+func (p *AssetsPaginator) Filtered() FilterCounts {
+	return p.filtered
+}
+
+// CollectionsPaginator walks the pages of the collections of an owner. The
+// endpoint does not return cursors, so it always uses offsets.
+type CollectionsPaginator struct {
+	client *OpenSeaClient
+	owner  Address
+	state  pageState
+}
+
+// NewCollectionsPaginator returns a paginator over the collections in which
+// owner holds assets.
+func (c *OpenSeaClient) NewCollectionsPaginator(owner Address) *CollectionsPaginator {
+	return &CollectionsPaginator{
+		client: c,
+		owner:  owner,
+		state: pageState{
+			mode:     PaginationOffset,
+			pageSize: c.limitAssets,
+		},
+	}
+}
+
+// HasNextPage reports whether there are more pages to fetch.
+func (p *CollectionsPaginator) HasNextPage() bool {
+	return !p.state.done
+}
+
+// Offset returns the offset of the next page.
+func (p *CollectionsPaginator) Offset() int {
+	return p.state.offset
+}
+
+// NextPage fetches the next page. On error the paginator stays on the same
+// page, so calling NextPage again retries it.
+func (p *CollectionsPaginator) NextPage(ctx context.Context) ([]Collection, error) {
+	if p.state.done {
+		return nil, nil
+	}
+
+	collections, err := p.client.GetCollectionsWithOffsetContext(ctx, p.owner, p.state.offset)
+	if err != nil {
+		return collections, err
+	}
+
+	p.state.advance(len(collections), "")
+	return collections, nil
+}
+
+// EventsPaginator walks the pages of an events query. The endpoint only
+// supports cursors, so it ignores the pagination mode of the client.
+type EventsPaginator struct {
+	client   *OpenSeaClient
+	query    EventsQuery
+	state    pageState
+	filtered FilterCounts
+}
+
+// NewEventsPaginator returns a paginator over the events matching query,
+// starting at query.Cursor.
+func (c *OpenSeaClient) NewEventsPaginator(query EventsQuery) *EventsPaginator {
+	return &EventsPaginator{
+		client: c,
+		query:  query,
+		state: pageState{
+			mode:   PaginationCursor,
+			cursor: query.Cursor,
+		},
+	}
+}
+
+// HasNextPage reports whether there are more pages to fetch.
+func (p *EventsPaginator) HasNextPage() bool {
+	return !p.state.done
+}
+
+// Cursor returns the cursor of the next page. It is empty before the first
+// page.
+func (p *EventsPaginator) Cursor() string {
+	return p.state.cursor
+}
+
+// NextPage fetches the next page. On error the paginator stays on the same
+// page, so calling NextPage again retries it.
+func (p *EventsPaginator) NextPage(ctx context.Context) (GetEventsResponse, error) {
+	if p.state.done {
+		return GetEventsResponse{}, nil
+	}
+
+	p.query.Cursor = p.state.cursor
+	resp, err := p.client.GetEventsContext(ctx, p.query)
+	if err != nil {
+		return resp, err
+	}
+
+	p.state.advance(len(resp.AssetEvents)+resp.Filtered.Total(), resp.Next)
+	p.filtered = p.filtered.merge(resp.Filtered)
+	return resp, nil
+}
+
+// Filtered returns the number of events dropped by the filters of the
+// client on the pages fetched so far.
+func (p *EventsPaginator) Filtered() FilterCounts {
+	return p.filtered
+}
+
+// SeaportOrdersPaginator walks the pages of Seaport listings or offers. The
+// endpoints only support cursors, so it ignores the pagination mode of the
+// client.
+type SeaportOrdersPaginator struct {
+	client *OpenSeaClient
+	kind   string
+	chain  Chain
+	query  SeaportOrdersQuery
+	state  pageState
+}
+
+// NewListingsPaginator returns a paginator over the Seaport listings on
+// chain matching query, starting at query.Cursor.
+func (c *OpenSeaClient) NewListingsPaginator(chain Chain, query SeaportOrdersQuery) *SeaportOrdersPaginator {
+	return c.newSeaportOrdersPaginator(seaportListings, chain, query)
+}
+
+// NewOffersPaginator returns a paginator over the Seaport offers on chain
+// matching query, starting at query.Cursor.
+func (c *OpenSeaClient) NewOffersPaginator(chain Chain, query SeaportOrdersQuery) *SeaportOrdersPaginator {
+	return c.newSeaportOrdersPaginator(seaportOffers, chain, query)
+}
+
+func (c *OpenSeaClient) newSeaportOrdersPaginator(kind string, chain Chain, query SeaportOrdersQuery) *SeaportOrdersPaginator {
+	return &SeaportOrdersPaginator{
+		client: c,
+		kind:   kind,
+		chain:  chain,
+		query:  query,
+		state: pageState{
+			mode:   PaginationCursor,
+			cursor: query.Cursor,
+		},
+	}
+}
+
+// HasNextPage reports whether there are more pages to fetch.
+func (p *SeaportOrdersPaginator) HasNextPage() bool {
+	return !p.state.done
+}
+
+// Cursor returns the cursor of the next page. It is empty before the first
+// page.
+func (p *SeaportOrdersPaginator) Cursor() string {
+	return p.state.cursor
+}
+
+// NextPage fetches the next page. On error the paginator stays on the same
+// page, so calling NextPage again retries it.
+func (p *SeaportOrdersPaginator) NextPage(ctx context.Context) (GetSeaportOrdersResponse, error) {
+	if p.state.done {
+		return GetSeaportOrdersResponse{}, nil
+	}
+
+	p.query.Cursor = p.state.cursor
+	resp, err := p.client.getSeaportOrders(ctx, p.kind, p.chain, p.query)
+	if err != nil {
+		return resp, err
+	}
+
+	p.state.advance(len(resp.Orders), resp.Next)
+	return resp, nil
+}
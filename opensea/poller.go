@@ -0,0 +1,272 @@
+package opensea
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EventCheckpoint is the position of an EventPoller in the events of a
+// collection.
+type EventCheckpoint struct {
+	Slug string
+	// Time is the timestamp of the newest delivered event.
+	Time time.Time
+	// IDs are the IDs of the delivered events with timestamp Time, used to
+	// skip them when the next poll returns them again.
+	IDs []int64
+}
+
+// seen reports whether an event with the given ID and timestamp has already
+// been delivered.
+func (cp EventCheckpoint) seen(id int64, t time.Time) bool {
+	if t.Before(cp.Time) {
+		return true
+	}
+	if t.After(cp.Time) {
+		return false
+	}
+	for _, seenID := range cp.IDs {
+		if seenID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// advance moves the checkpoint past a delivered event.
+func (cp *EventCheckpoint) advance(id int64, t time.Time) {
+	if t.After(cp.Time) {
+		cp.Time = t
+		cp.IDs = nil
+	}
+	cp.IDs = append(cp.IDs, id)
+}
+
+// CheckpointStore persists event checkpoints.
+type CheckpointStore interface {
+	// LoadCheckpoint returns the checkpoint of slug. It returns false if
+	// there is none.
+	LoadCheckpoint(ctx context.Context, slug string) (EventCheckpoint, bool, error)
+	// SaveCheckpoint stores a checkpoint, replacing the previous one of the
+	// same slug.
+	SaveCheckpoint(ctx context.Context, checkpoint EventCheckpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore keeping checkpoints in memory.
+// It is safe for concurrent use.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]EventCheckpoint
+}
+
+// NewMemoryCheckpointStore creates an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{
+		checkpoints: make(map[string]EventCheckpoint),
+	}
+}
+
+// LoadCheckpoint implements CheckpointStore.
+func (s *MemoryCheckpointStore) LoadCheckpoint(ctx context.Context, slug string) (EventCheckpoint, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cp, ok := s.checkpoints[slug]
+	cp.IDs = append([]int64(nil), cp.IDs...)
+	return cp, ok, nil
+}
+
+// SaveCheckpoint implements CheckpointStore.
+func (s *MemoryCheckpointStore) SaveCheckpoint(ctx context.Context, checkpoint EventCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoint.IDs = append([]int64(nil), checkpoint.IDs...)
+	s.checkpoints[checkpoint.Slug] = checkpoint
+	return nil
+}
+
+// EventHandler handles the events delivered by an EventPoller.
+type EventHandler interface {
+	HandleEvent(ctx context.Context, event Event) error
+}
+
+// EventHandlerFunc adapts a function to an EventHandler.
+type EventHandlerFunc func(ctx context.Context, event Event) error
+
+// HandleEvent implements EventHandler.
+func (f EventHandlerFunc) HandleEvent(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// EventPoller repeatedly queries the events of a set of collections and
+// delivers every new event once to its handlers, oldest first. Requests go
+// through the client, so they are subject to its rate limiters and retry
+// policy.
+//
+// Delivery is at least once: the checkpoint of a collection is only saved
+// after its handlers succeeded, so an event whose handler failed is
+// delivered again, together with the newer ones, on the next poll.
+type EventPoller struct {
+	// EventType restricts the polled events to a single type. Empty polls
+	// all types.
+	EventType EventType
+	// Start is where collections without a checkpoint start. Zero starts at
+	// the time of their first poll.
+	Start time.Time
+	// OnFiltered, if set, is called after each poll of a collection in
+	// which the filters of the client dropped events, with their number.
+	// Dropped events newer than the checkpoint are fetched and counted again
+	// on the next poll.
+	OnFiltered func(slug string, filtered FilterCounts)
+
+	client   *OpenSeaClient
+	store    CheckpointStore
+	interval time.Duration
+	slugs    []string
+	handlers []EventHandler
+	now      func() time.Time
+}
+
+// NewEventPoller creates a poller querying the events of slugs every
+// interval and tracking its progress in store. The interval must be positive.
+func NewEventPoller(c *OpenSeaClient, store CheckpointStore, interval time.Duration, slugs ...string) (*EventPoller, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("opensea: invalid poll interval %s: must be positive", interval)
+	}
+	return &EventPoller{
+		client:   c,
+		store:    store,
+		interval: interval,
+		slugs:    slugs,
+		now:      time.Now,
+	}, nil
+}
+
+// Handle registers a handler. Handlers are called in the order they were
+// registered and must be registered before polling starts.
+func (p *EventPoller) Handle(h EventHandler) {
+	p.handlers = append(p.handlers, h)
+}
+
+// HandleFunc registers a handler function.
+func (p *EventPoller) HandleFunc(f func(ctx context.Context, event Event) error) {
+	p.Handle(EventHandlerFunc(f))
+}
+
+// PollOnce polls every collection once. A failing collection does not
+// prevent the others from being polled; the first error is returned.
+func (p *EventPoller) PollOnce(ctx context.Context) error {
+	var firstErr error
+	for _, slug := range p.slugs {
+		if err := p.poll(ctx, slug); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			p.client.logf(EventError, "Error polling events of %s: %s", slug, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// Run polls immediately and then every interval until ctx is done. Errors
+// of single rounds are logged and do not stop the poller.
+func (p *EventPoller) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.PollOnce(ctx); err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll delivers the new events of a single collection.
+func (p *EventPoller) poll(ctx context.Context, slug string) error {
+	cp, ok, err := p.store.LoadCheckpoint(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		cp = EventCheckpoint{Slug: slug, Time: p.Start}
+		if cp.Time.IsZero() {
+			cp.Time = p.now().UTC()
+		}
+	}
+
+	// occurred_after has a resolution of seconds, so events sharing the
+	// second of the checkpoint are requested again and skipped by ID.
+	query := EventsQuery{
+		CollectionSlug: slug,
+		EventType:      p.EventType,
+		OccurredAfter:  cp.Time.Truncate(time.Second).Add(-time.Second),
+	}
+	events, filtered, err := p.client.getAllEvents(ctx, query)
+	if err != nil {
+		return err
+	}
+	if p.OnFiltered != nil && len(filtered) > 0 {
+		p.OnFiltered(slug, filtered)
+	}
+
+	type timedEvent struct {
+		event Event
+		time  time.Time
+	}
+	var pending []timedEvent
+	for _, event := range events {
+		if event.EventTimestamp.IsZero() {
+			return fmt.Errorf("opensea: event %d has no timestamp", event.ID)
+		}
+		t := event.EventTimestamp.Time
+		if !cp.seen(event.ID, t) {
+			pending = append(pending, timedEvent{event, t})
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		if !pending[i].time.Equal(pending[j].time) {
+			return pending[i].time.Before(pending[j].time)
+		}
+		return pending[i].event.ID < pending[j].event.ID
+	})
+
+	// Save the progress made so far even if a handler fails.
+	delivered := 0
+	for _, e := range pending {
+		if err = p.deliver(ctx, e.event); err != nil {
+			break
+		}
+		cp.advance(e.event.ID, e.time)
+		delivered++
+	}
+	if ok && delivered == 0 {
+		return err
+	}
+	if saveErr := p.store.SaveCheckpoint(ctx, cp); saveErr != nil && err == nil {
+		err = saveErr
+	}
+	return err
+}
+
+// deliver passes an event to every handler, stopping at the first error.
+func (p *EventPoller) deliver(ctx context.Context, event Event) error {
+	for _, h := range p.handlers {
+		if err := h.HandleEvent(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
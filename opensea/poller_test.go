@@ -0,0 +1,236 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+// newEventsServer serves the events returned by events, newest first,
+// filtered by occurred_after.
+func newEventsServer(t *testing.T, events func() []string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/events" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		var after int64
+		fmt.Sscan(r.URL.Query().Get("occurred_after"), &after)
+
+		var page []string
+		for _, e := range events() {
+			var id, ts int64
+			fmt.Sscanf(e, "%d@%d", &id, &ts)
+			if ts > after {
+				page = append(page, fmt.Sprintf(`{"id": %d, "event_type": "transfer", "event_timestamp": %q}`,
+					id, time.Unix(ts, 0).UTC().Format("2006-01-02T15:04:05")))
+			}
+		}
+		fmt.Fprintf(w, `{"asset_events": [%s], "next": null}`, strings.Join(page, ","))
+	}))
+}
+
+func TestEventPoller(t *testing.T) {
+	start := time.Date(2022, 3, 20, 18, 0, 0, 0, time.UTC).Unix()
+	var (
+		mu     sync.Mutex
+		events []string
+	)
+	publish := func(id int64, offset int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append([]string{fmt.Sprintf("%d@%d", id, start+offset)}, events...)
+	}
+	server := newEventsServer(t, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), events...)
+	})
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+	}
+	store := NewMemoryCheckpointStore()
+	p, err := NewEventPoller(c, store, time.Minute, "boredapeyachtclub")
+	if err != nil {
+		t.Fatalf("NewEventPoller() error = %v", err)
+	}
+	p.Start = time.Unix(start, 0)
+
+	var (
+		got  []int64
+		fail bool
+	)
+	p.HandleFunc(func(ctx context.Context, event Event) error {
+		if fail && event.ID == 4 {
+			return errors.New("handler failed")
+		}
+		got = append(got, event.ID)
+		return nil
+	})
+
+	ctx := context.Background()
+	publish(1, 10)
+	publish(2, 20)
+	publish(3, 20)
+	if err := p.PollOnce(ctx); err != nil {
+		t.Fatalf("EventPoller.PollOnce() error = %v", err)
+	}
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("delivered %v, want %v", got, want)
+	}
+
+	// Events sharing the second of the checkpoint are returned again but
+	// delivered only once.
+	got = nil
+	publish(4, 20)
+	publish(5, 30)
+	fail = true
+	if err := p.PollOnce(ctx); err == nil {
+		t.Fatal("EventPoller.PollOnce() error = nil, want handler error")
+	}
+	if got != nil {
+		t.Fatalf("delivered %v before the failing event, want none", got)
+	}
+
+	fail = false
+	if err := p.PollOnce(ctx); err != nil {
+		t.Fatalf("EventPoller.PollOnce() error = %v", err)
+	}
+	if want := []int64{4, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("delivered %v, want %v", got, want)
+	}
+
+	cp, ok, err := store.LoadCheckpoint(ctx, "boredapeyachtclub")
+	if err != nil || !ok {
+		t.Fatalf("MemoryCheckpointStore.LoadCheckpoint() = %v, %v", ok, err)
+	}
+	want := EventCheckpoint{Slug: "boredapeyachtclub", Time: time.Unix(start+30, 0).UTC(), IDs: []int64{5}}
+	if !reflect.DeepEqual(cp, want) {
+		t.Errorf("checkpoint = %+v, want %+v", cp, want)
+	}
+
+	// A new poller resumes from the stored checkpoint.
+	got = nil
+	p, err = NewEventPoller(c, store, time.Minute, "boredapeyachtclub")
+	if err != nil {
+		t.Fatalf("NewEventPoller() error = %v", err)
+	}
+	p.HandleFunc(func(ctx context.Context, event Event) error {
+		got = append(got, event.ID)
+		return nil
+	})
+	publish(6, 30)
+	if err := p.PollOnce(ctx); err != nil {
+		t.Fatalf("EventPoller.PollOnce() error = %v", err)
+	}
+	if want := []int64{6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("delivered %v after resuming, want %v", got, want)
+	}
+}
+
+func TestEventPoller_Run(t *testing.T) {
+	requests := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- struct{}{}
+		w.Write([]byte(`{"asset_events": [], "next": null}`))
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+	}
+	p, err := NewEventPoller(c, NewMemoryCheckpointStore(), time.Millisecond*10, "boredapeyachtclub")
+	if err != nil {
+		t.Fatalf("NewEventPoller() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- p.Run(ctx) }()
+
+	<-requests
+	<-requests
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("EventPoller.Run() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestNewEventPoller_InvalidInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if _, err := NewEventPoller(&OpenSeaClient{}, NewMemoryCheckpointStore(), interval); err == nil {
+			t.Errorf("NewEventPoller(%s) should fail", interval)
+		}
+	}
+}
+
+func TestEventPoller_OnFiltered(t *testing.T) {
+	data, err := os.ReadFile("../testdata/get_events.json")
+	if err != nil {
+		t.Fatalf("Failed to read fixture file: %s", err)
+	}
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal(data, &page); err != nil {
+		t.Fatalf("Failed to decode fixture file: %s", err)
+	}
+	page["next"] = json.RawMessage("null")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	tokenID := FixtureGetEventsResp.AssetEvents[0].Asset.TokenID
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+		filters: []Filter{NewFilter("custom", func(a Asset) bool {
+			return a.TokenID.Equal(tokenID)
+		})},
+	}
+	p, err := NewEventPoller(c, NewMemoryCheckpointStore(), time.Minute, "boredapeyachtclub")
+	if err != nil {
+		t.Fatalf("NewEventPoller() error = %v", err)
+	}
+	p.Start = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var (
+		delivered int
+		filtered  FilterCounts
+	)
+	p.HandleFunc(func(ctx context.Context, event Event) error {
+		delivered++
+		return nil
+	})
+	p.OnFiltered = func(slug string, counts FilterCounts) {
+		if slug != "boredapeyachtclub" {
+			t.Errorf("OnFiltered() slug = %s", slug)
+		}
+		filtered = counts
+	}
+	if err := p.PollOnce(context.Background()); err != nil {
+		t.Fatalf("EventPoller.PollOnce() error = %v", err)
+	}
+	if filtered["custom"] == 0 || delivered+filtered.Total() != len(FixtureGetEventsResp.AssetEvents) {
+		t.Errorf("EventPoller delivered %d and filtered %v of %d events", delivered, filtered, len(FixtureGetEventsResp.AssetEvents))
+	}
+}
@@ -0,0 +1,335 @@
+package opensea
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ethDecimals is the number of decimals of ETH and of prices whose payment
+// token is unknown.
+const ethDecimals = 18
+
+// Decimal is an exact decimal number such as an exchange rate. Like
+// json.Number it keeps the text of the number; it decodes from both JSON
+// numbers and numeric strings and encodes as a JSON number.
+type Decimal string
+
+// Rat returns the value of d. An empty Decimal is zero.
+func (d Decimal) Rat() (*big.Rat, error) {
+	if d == "" {
+		return new(big.Rat), nil
+	}
+	r, ok := parseRat(string(d))
+	if !ok {
+		return nil, fmt.Errorf("opensea: invalid decimal %q", string(d))
+	}
+	return r, nil
+}
+
+// Float64 returns the nearest float64 value of d, or zero if d is invalid.
+func (d Decimal) Float64() float64 {
+	r, err := d.Rat()
+	if err != nil {
+		return 0
+	}
+	f, _ := r.Float64()
+	return f
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s, err := unquoteNumber(data)
+	if err != nil {
+		return err
+	}
+	if s != "" {
+		if _, err := Decimal(s).Rat(); err != nil {
+			return err
+		}
+	}
+	*d = Decimal(s)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. An empty Decimal encodes as null.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d == "" {
+		return []byte("null"), nil
+	}
+	if _, err := d.Rat(); err != nil {
+		return nil, err
+	}
+	return []byte(d), nil
+}
+
+// PaymentToken is a token accepted as payment, with its exchange rates at
+// the time of the response.
+type PaymentToken struct {
+	Address Address `json:"address"`
+	// Decimals is nil when the response does not give the decimals of the
+	// token, as 0 is a valid number of decimals.
+	Decimals *int    `json:"decimals,omitempty"`
+	EthPrice Decimal `json:"eth_price"`
+	ID       int     `json:"id"`
+	ImageURL string  `json:"image_url"`
+	Name     string  `json:"name"`
+	Symbol   string  `json:"symbol"`
+	UsdPrice Decimal `json:"usd_price"`
+}
+
+// ToETH returns the value of p in ETH at the rate of the token.
+func (t PaymentToken) ToETH(p Price) (*big.Rat, error) {
+	return p.convert(t.EthPrice)
+}
+
+// ToUSD returns the value of p in USD at the rate of the token.
+func (t PaymentToken) ToUSD(p Price) (*big.Rat, error) {
+	return p.convert(t.UsdPrice)
+}
+
+// Format formats p with at most digits decimals followed by the symbol of
+// the token, e.g. "0.2367 WETH".
+func (t PaymentToken) Format(p Price, digits int) string {
+	if t.Symbol == "" {
+		return p.Format(digits)
+	}
+	return p.Format(digits) + " " + t.Symbol
+}
+
+// Price is an amount of a payment token in its smallest unit, e.g. wei for
+// ETH, together with the number of decimals of the token.
+//
+// Prices decoded as part of an order or an event take their decimals from
+// its payment token; prices without a known token have 18 decimals.
+type Price struct {
+	Amount   *big.Int
+	Decimals int
+}
+
+// NewPrice returns a price of amount smallest units of a token with the
+// given decimals.
+func NewPrice(amount *big.Int, decimals int) Price {
+	return Price{Amount: normalizeInt(new(big.Int).Set(amount)), Decimals: decimals}
+}
+
+// ParsePrice parses an amount in the smallest unit of a token, e.g.
+// "236700000000000000". OpenSea sometimes returns amounts with a fractional
+// part or in exponent notation; fractions of the smallest unit are
+// truncated.
+func ParsePrice(s string, decimals int) (Price, error) {
+	r, ok := parseRat(s)
+	if !ok {
+		return Price{}, fmt.Errorf("opensea: invalid price %q", s)
+	}
+	amount := new(big.Int).Quo(r.Num(), r.Denom())
+	return Price{Amount: normalizeInt(amount), Decimals: decimals}, nil
+}
+
+// MustParsePrice is like ParsePrice but panics on error.
+func MustParsePrice(s string, decimals int) Price {
+	p, err := ParsePrice(s, decimals)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// ParseUnits parses an amount in whole tokens, e.g. "0.2367" ETH.
+func ParseUnits(s string, decimals int) (Price, error) {
+	r, ok := parseRat(s)
+	if !ok {
+		return Price{}, fmt.Errorf("opensea: invalid amount %q", s)
+	}
+	r.Mul(r, new(big.Rat).SetInt(pow10(decimals)))
+	amount := new(big.Int).Quo(r.Num(), r.Denom())
+	return Price{Amount: normalizeInt(amount), Decimals: decimals}, nil
+}
+
+// IsZero reports whether p is zero or unset.
+func (p Price) IsZero() bool {
+	return p.Amount == nil || p.Amount.Sign() == 0
+}
+
+// Units returns p in whole tokens, e.g. ETH rather than wei.
+func (p Price) Units() *big.Rat {
+	r := new(big.Rat)
+	if p.Amount == nil {
+		return r
+	}
+	return r.SetFrac(p.Amount, pow10(p.Decimals))
+}
+
+// Add returns p + q. Both prices must have the same decimals.
+func (p Price) Add(q Price) (Price, error) {
+	if p.Decimals != q.Decimals {
+		return Price{}, fmt.Errorf("opensea: cannot add prices with %d and %d decimals", p.Decimals, q.Decimals)
+	}
+	sum := new(big.Int).Add(p.amount(), q.amount())
+	return Price{Amount: normalizeInt(sum), Decimals: p.Decimals}, nil
+}
+
+// Cmp compares p and q by amount, returning -1, 0 or +1. Both prices should
+// have the same decimals.
+func (p Price) Cmp(q Price) int {
+	return p.amount().Cmp(q.amount())
+}
+
+// Fee returns the share of p given by a fee in basis points, rounded down to
+// the smallest unit.
+func (p Price) Fee(fee BasisPoints) Price {
+	amount := new(big.Int).Mul(p.amount(), big.NewInt(int64(fee)))
+	amount.Quo(amount, big.NewInt(10000))
+	return Price{Amount: normalizeInt(amount), Decimals: p.Decimals}
+}
+
+// Format formats p in whole tokens with at most digits decimals, rounding
+// half away from zero and dropping trailing zeros, e.g. "0.2367".
+func (p Price) Format(digits int) string {
+	s := p.Units().FloatString(digits)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// String formats p in whole tokens at full precision.
+func (p Price) String() string {
+	return p.Format(p.Decimals)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts amounts in the
+// smallest unit as numbers or strings. The decimals are set to 18 and
+// replaced by those of the payment token when decoding an order or event.
+func (p *Price) UnmarshalJSON(data []byte) error {
+	s, err := unquoteNumber(data)
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		*p = Price{}
+		return nil
+	}
+	v, err := ParsePrice(s, ethDecimals)
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The amount is encoded as a string
+// in the smallest unit like the API does. An unset price encodes as null.
+func (p Price) MarshalJSON() ([]byte, error) {
+	if p.Amount == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(p.Amount.String())
+}
+
+func (p Price) amount() *big.Int {
+	if p.Amount == nil {
+		return new(big.Int)
+	}
+	return p.Amount
+}
+
+// convert multiplies the units of p with rate.
+func (p Price) convert(rate Decimal) (*big.Rat, error) {
+	r, err := rate.Rat()
+	if err != nil {
+		return nil, err
+	}
+	return r.Mul(r, p.Units()), nil
+}
+
+// withDecimals sets the decimals of p to those of token, if known.
+func (p *Price) withDecimals(token *PaymentToken) {
+	if token != nil && token.Decimals != nil {
+		p.Decimals = *token.Decimals
+	}
+}
+
+// parseRat parses a decimal number with an optional sign, fraction and
+// exponent. Unlike big.Rat.SetString it rejects fractions such as "1/3" and
+// hexadecimal numbers, which cannot be encoded back as JSON numbers.
+func parseRat(s string) (*big.Rat, bool) {
+	digits := func(i int) int {
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		return i
+	}
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	start := i
+	i = digits(i)
+	n := i - start
+	if i < len(s) && s[i] == '.' {
+		j := digits(i + 1)
+		n += j - i - 1
+		i = j
+	}
+	if n == 0 {
+		return nil, false
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		j := digits(i)
+		if j == i {
+			return nil, false
+		}
+		i = j
+	}
+	if i != len(s) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// unquoteNumber returns the text of a JSON number or string, or an empty
+// string for null.
+func unquoteNumber(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(s), nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return "", fmt.Errorf("opensea: invalid number %s", data)
+	}
+	return n.String(), nil
+}
+
+// normalizeInt returns x, replacing zero by a freshly allocated zero so that
+// equal prices are deeply equal.
+func normalizeInt(x *big.Int) *big.Int {
+	if x.Sign() == 0 {
+		return new(big.Int)
+	}
+	return x
+}
+
+// intPtr returns a pointer to n, for optional fields such as
+// PaymentToken.Decimals.
+func intPtr(n int) *int {
+	return &n
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
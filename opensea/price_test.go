@@ -0,0 +1,207 @@
+package opensea
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{name: "Integer", s: "236700000000000000", want: "236700000000000000"},
+		{name: "Trailing zeros", s: "236700000000000000.0000000000", want: "236700000000000000"},
+		{name: "Exponent", s: "1.05e+20", want: "105000000000000000000"},
+		{name: "Fraction of a wei", s: "1234.56", want: "1234"},
+		{name: "Beyond uint64", s: "340282366920938463463374607431768211456", want: "340282366920938463463374607431768211456"},
+		{name: "Invalid", s: "0.1 ETH", wantErr: true},
+		{name: "Fraction", s: "1/3", wantErr: true},
+		{name: "Hexadecimal", s: "0x10", wantErr: true},
+		{name: "Empty exponent", s: "1e", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePrice(tt.s, 18)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePrice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Amount.String() != tt.want {
+				t.Errorf("ParsePrice() = %s, want %s", got.Amount, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrice_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		price  Price
+		digits int
+		want   string
+	}{
+		{name: "Wei as ETH", price: MustParsePrice("236700000000000000", 18), digits: 4, want: "0.2367"},
+		{name: "Rounded", price: MustParsePrice("236750000000000000", 18), digits: 3, want: "0.237"},
+		{name: "Whole tokens", price: MustParsePrice("105000000000000000000", 18), digits: 4, want: "105"},
+		{name: "USDC", price: MustParsePrice("1500000", 6), digits: 2, want: "1.5"},
+		{name: "Unset", price: Price{}, digits: 2, want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.price.Format(tt.digits); got != tt.want {
+				t.Errorf("Price.Format() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if got := MustParsePrice("1", 18).String(); got != "0.000000000000000001" {
+		t.Errorf("Price.String() = %s, want 0.000000000000000001", got)
+	}
+}
+
+func TestPrice_Arithmetic(t *testing.T) {
+	price, err := ParseUnits("0.2367", 18)
+	if err != nil {
+		t.Fatalf("ParseUnits() error = %v", err)
+	}
+	if price.Cmp(MustParsePrice("236700000000000000", 18)) != 0 {
+		t.Fatalf("ParseUnits() = %s, want 236700000000000000", price.Amount)
+	}
+
+	fee := price.Fee(250)
+	if want := "5917500000000000"; fee.Amount.String() != want {
+		t.Errorf("Price.Fee() = %s, want %s", fee.Amount, want)
+	}
+	total, err := price.Add(fee)
+	if err != nil {
+		t.Fatalf("Price.Add() error = %v", err)
+	}
+	if want := "242617500000000000"; total.Amount.String() != want {
+		t.Errorf("Price.Add() = %s, want %s", total.Amount, want)
+	}
+	if _, err := price.Add(MustParsePrice("1", 6)); err == nil {
+		t.Error("Price.Add() with different decimals should fail")
+	}
+}
+
+func TestPaymentToken_Convert(t *testing.T) {
+	token := FixtureGetOrdersResp.Orders[0].PaymentTokenContract
+	price := FixtureGetOrdersResp.Orders[0].CurrentPrice
+
+	eth, err := token.ToETH(price)
+	if err != nil {
+		t.Fatalf("PaymentToken.ToETH() error = %v", err)
+	}
+	if want := big.NewRat(2367, 10000); eth.Cmp(want) != 0 {
+		t.Errorf("PaymentToken.ToETH() = %s, want %s", eth.FloatString(4), want.FloatString(4))
+	}
+
+	usd, err := token.ToUSD(price)
+	if err != nil {
+		t.Fatalf("PaymentToken.ToUSD() error = %v", err)
+	}
+	if want := "580.97"; usd.FloatString(2) != want {
+		t.Errorf("PaymentToken.ToUSD() = %s, want %s", usd.FloatString(2), want)
+	}
+
+	if got := token.Format(price, 4); got != "0.2367 ETH" {
+		t.Errorf("PaymentToken.Format() = %s, want 0.2367 ETH", got)
+	}
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	var token PaymentToken
+	if err := json.Unmarshal([]byte(`{"eth_price": 0.00038164, "usd_price": "1.000000000000000"}`), &token); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if token.EthPrice != "0.00038164" || token.UsdPrice != "1.000000000000000" {
+		t.Errorf("json.Unmarshal() = %+v", token)
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"address":"","eth_price":0.00038164,"id":0,"image_url":"","name":"","symbol":"","usd_price":1.000000000000000}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	for _, price := range []string{`"n/a"`, `"1/3"`, `"0x10"`} {
+		if err := json.Unmarshal([]byte(`{"eth_price": `+price+`}`), &token); err == nil {
+			t.Errorf("json.Unmarshal() of the invalid decimal %s should fail", price)
+		}
+	}
+}
+
+func TestOrder_UnmarshalJSON_Decimals(t *testing.T) {
+	var order Order
+	data := `{"current_price": "1500000.000", "base_price": "1500000", "payment_token_contract": {"symbol": "USDC", "decimals": 6, "usd_price": "1.000000000000000"}}`
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if order.CurrentPrice.Decimals != 6 || order.BasePrice.Decimals != 6 {
+		t.Fatalf("decimals = %d, %d, want 6", order.CurrentPrice.Decimals, order.BasePrice.Decimals)
+	}
+	if got := order.PaymentTokenContract.Format(order.CurrentPrice, 2); got != "1.5 USDC" {
+		t.Errorf("PaymentToken.Format() = %s, want 1.5 USDC", got)
+	}
+	if order.CurrentBounty.Amount != nil {
+		t.Errorf("CurrentBounty = %v, want unset", order.CurrentBounty.Amount)
+	}
+}
+
+func TestOrder_UnmarshalJSON_ZeroDecimals(t *testing.T) {
+	var order Order
+	data := `{"current_price": "15", "payment_token_contract": {"symbol": "PTS", "decimals": 0}}`
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if order.CurrentPrice.Decimals != 0 || order.CurrentPrice.String() != "15" {
+		t.Errorf("CurrentPrice = %s with %d decimals, want 15 with 0", order.CurrentPrice, order.CurrentPrice.Decimals)
+	}
+
+	data = `{"current_price": "15", "payment_token_contract": {"symbol": "PTS"}}`
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if order.CurrentPrice.Decimals != 18 {
+		t.Errorf("CurrentPrice.Decimals = %d, want 18 for a token without decimals", order.CurrentPrice.Decimals)
+	}
+}
+
+func TestOrder_JSONRoundTrip_Decimals(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{name: "Without decimals", token: `{"symbol": "ETH"}`, want: "1"},
+		{name: "Zero decimals", token: `{"symbol": "PTS", "decimals": 0}`, want: "1000000000000000000"},
+		{name: "USDC", token: `{"symbol": "USDC", "decimals": 6}`, want: "1000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var order Order
+			data := `{"current_price": "1000000000000000000", "payment_token_contract": ` + tt.token + `}`
+			if err := json.Unmarshal([]byte(data), &order); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			encoded, err := json.Marshal(order)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			var got Order
+			if err := json.Unmarshal(encoded, &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got.CurrentPrice.String() != tt.want || !reflect.DeepEqual(got.PaymentTokenContract, order.PaymentTokenContract) {
+				t.Errorf("round trip = %s %+v, want %s %+v", got.CurrentPrice, got.PaymentTokenContract, tt.want, order.PaymentTokenContract)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package opensea
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Pseudo traits added by ComputeRarity.
+const (
+	// TraitTypeTraitCount is the trait type of the pseudo trait holding the
+	// number of traits of an asset.
+	TraitTypeTraitCount = "Trait Count"
+	// TraitValueNone is the value of the pseudo trait given to assets that
+	// lack a trait type present in the collection.
+	TraitValueNone = "None"
+)
+
+// RarityOptions configures ComputeRarity.
+type RarityOptions struct {
+	// IncludeMissingTraits counts the absence of a trait type as a trait
+	// with the value TraitValueNone.
+	IncludeMissingTraits bool
+	// IncludeTraitCount adds a TraitTypeTraitCount trait holding the number
+	// of traits of every asset.
+	IncludeTraitCount bool
+}
+
+// TraitFrequency is the number of assets having a trait value.
+type TraitFrequency struct {
+	TraitType string
+	Value     string
+	Count     int
+	// Frequency is Count divided by the number of assets.
+	Frequency float64
+}
+
+// AssetRarity holds the rarity of a single asset. Rank 1 is the rarest.
+type AssetRarity struct {
+	Asset Asset
+	// Traits are the traits used to score the asset, including pseudo traits.
+	Traits []TraitFrequency
+	// RarityScore is the sum of 1/frequency over all traits.
+	RarityScore     float64
+	RarityScoreRank int
+	// StatisticalRarity is the product of the trait frequencies, i.e. the
+	// chance of an asset with the same traits.
+	StatisticalRarity     float64
+	StatisticalRarityRank int
+}
+
+// RarityTable holds the trait frequencies and rarity rankings of a collection.
+type RarityTable struct {
+	// Total is the number of assets.
+	Total int
+	// Assets is sorted by RarityScoreRank.
+	Assets []AssetRarity
+
+	frequencies map[string]map[string]*TraitFrequency
+}
+
+// ComputeRarity computes the rarity of assets, which should be all assets of
+// a collection. Numeric and date traits are ignored since they are not
+// categorical.
+func ComputeRarity(assets []Asset, opts RarityOptions) *RarityTable {
+	t := &RarityTable{
+		Total:       len(assets),
+		frequencies: make(map[string]map[string]*TraitFrequency),
+	}
+
+	traits := make([][]assetTrait, len(assets))
+	counts := make([]int, len(assets))
+	for i, asset := range assets {
+		traits[i] = categoricalTraits(asset)
+		counts[i] = len(traits[i])
+	}
+
+	if opts.IncludeMissingTraits {
+		var types []string
+		seen := make(map[string]bool)
+		for _, tr := range traits {
+			for _, a := range tr {
+				if !seen[a.traitType] {
+					seen[a.traitType] = true
+					types = append(types, a.traitType)
+				}
+			}
+		}
+		for i, tr := range traits {
+			has := make(map[string]bool, len(tr))
+			for _, a := range tr {
+				has[a.traitType] = true
+			}
+			for _, traitType := range types {
+				if !has[traitType] {
+					traits[i] = append(traits[i], assetTrait{traitType, TraitValueNone})
+				}
+			}
+		}
+	}
+
+	if opts.IncludeTraitCount {
+		for i := range traits {
+			traits[i] = append(traits[i], assetTrait{TraitTypeTraitCount, strconv.Itoa(counts[i])})
+		}
+	}
+
+	for _, tr := range traits {
+		for _, a := range tr {
+			t.count(a.traitType, a.value)
+		}
+	}
+	for _, values := range t.frequencies {
+		for _, f := range values {
+			f.Frequency = float64(f.Count) / float64(t.Total)
+		}
+	}
+
+	t.Assets = make([]AssetRarity, len(assets))
+	for i, asset := range assets {
+		r := AssetRarity{Asset: asset, StatisticalRarity: 1}
+		for _, a := range traits[i] {
+			r.Traits = append(r.Traits, *t.frequencies[a.traitType][a.value])
+		}
+		// Sum in trait order: floating point addition is not associative,
+		// and assets with the same traits must score the same.
+		sort.Slice(r.Traits, func(a, b int) bool {
+			if r.Traits[a].TraitType != r.Traits[b].TraitType {
+				return r.Traits[a].TraitType < r.Traits[b].TraitType
+			}
+			return r.Traits[a].Value < r.Traits[b].Value
+		})
+		for _, f := range r.Traits {
+			r.RarityScore += 1 / f.Frequency
+			r.StatisticalRarity *= f.Frequency
+		}
+		t.Assets[i] = r
+	}
+
+	rank(t.Assets, func(a, b AssetRarity) bool {
+		return a.StatisticalRarity < b.StatisticalRarity
+	}, func(r *AssetRarity, n int) {
+		r.StatisticalRarityRank = n
+	})
+	rank(t.Assets, func(a, b AssetRarity) bool {
+		return a.RarityScore > b.RarityScore
+	}, func(r *AssetRarity, n int) {
+		r.RarityScoreRank = n
+	})
+
+	return t
+}
+
+// Frequency returns the frequency of a trait value, or nil if no asset has it.
+func (t *RarityTable) Frequency(traitType, value string) *TraitFrequency {
+	f, ok := t.frequencies[traitType][value]
+	if !ok {
+		return nil
+	}
+	fc := *f
+	return &fc
+}
+
+// Frequencies returns the frequencies of all values of a trait type, rarest first.
+func (t *RarityTable) Frequencies(traitType string) []TraitFrequency {
+	var out []TraitFrequency
+	for _, f := range t.frequencies[traitType] {
+		out = append(out, *f)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count < out[j].Count
+		}
+		return out[i].Value < out[j].Value
+	})
+	return out
+}
+
+// ByStatisticalRarity returns the assets sorted by StatisticalRarityRank.
+func (t *RarityTable) ByStatisticalRarity() []AssetRarity {
+	out := make([]AssetRarity, len(t.Assets))
+	copy(out, t.Assets)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].StatisticalRarityRank < out[j].StatisticalRarityRank
+	})
+	return out
+}
+
+func (t *RarityTable) count(traitType, value string) {
+	values, ok := t.frequencies[traitType]
+	if !ok {
+		values = make(map[string]*TraitFrequency)
+		t.frequencies[traitType] = values
+	}
+	f, ok := values[value]
+	if !ok {
+		f = &TraitFrequency{TraitType: traitType, Value: value}
+		values[value] = f
+	}
+	f.Count++
+}
+
+// assetTrait is a categorical trait of an asset.
+type assetTrait struct {
+	traitType string
+	value     string
+}
+
+// categoricalTraits returns the string traits of an asset. A trait type may
+// occur with several values; repeated identical traits are counted once.
+func categoricalTraits(asset Asset) []assetTrait {
+	var traits []assetTrait
+	seen := make(map[assetTrait]bool)
+	for _, tr := range asset.Traits {
+		if tr.IsNumeric() || tr.DisplayType == TraitDisplayDate || tr.Value.IsZero() {
+			continue
+		}
+		a := assetTrait{tr.TraitType, tr.Value.String()}
+		if !seen[a] {
+			seen[a] = true
+			traits = append(traits, a)
+		}
+	}
+	return traits
+}
+
+// rank sorts assets with less and assigns competition ranks (1, 1, 3, ...),
+// breaking ties by asset ID.
+func rank(assets []AssetRarity, less func(a, b AssetRarity) bool, set func(r *AssetRarity, n int)) {
+	sort.SliceStable(assets, func(i, j int) bool {
+		if less(assets[i], assets[j]) {
+			return true
+		}
+		if less(assets[j], assets[i]) {
+			return false
+		}
+		return assets[i].Asset.ID < assets[j].Asset.ID
+	})
+	n := 0
+	for i := range assets {
+		if i == 0 || less(assets[i-1], assets[i]) {
+			n = i + 1
+		}
+		set(&assets[i], n)
+	}
+}
@@ -0,0 +1,225 @@
+package opensea
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func traitAsset(id int, traits ...Trait) Asset {
+	return Asset{ID: id, Traits: traits}
+}
+
+func stringTrait(traitType, value string) Trait {
+	return Trait{TraitType: traitType, Value: StringTraitValue(value)}
+}
+
+func TestTrait_UnmarshalJSON(t *testing.T) {
+	data := `[
+		{"trait_type": "Background", "value": "Blue", "display_type": null, "max_value": null, "trait_count": 1242, "order": null},
+		{"trait_type": "Level", "value": 5, "display_type": "number", "max_value": 10, "trait_count": 0, "order": null},
+		{"trait_type": "Stamina", "value": 1.5, "display_type": "boost_percentage", "max_value": null, "trait_count": 0, "order": null},
+		{"trait_type": "Birthday", "value": 1546360800, "display_type": "date", "max_value": null, "trait_count": 0, "order": null}
+	]`
+	var traits []Trait
+	if err := json.Unmarshal([]byte(data), &traits); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if traits[0].Value.String() != "Blue" || traits[0].Value.IsNumber() || traits[0].IsNumeric() || traits[0].TraitCount != 1242 {
+		t.Errorf("string trait = %+v", traits[0])
+	}
+	if v, ok := traits[1].Value.Float64(); !ok || v != 5 || !traits[1].IsNumeric() {
+		t.Errorf("number trait = %+v", traits[1])
+	}
+	if v, ok := traits[1].MaxValue.Float64(); !ok || v != 10 {
+		t.Errorf("number trait max value = %+v", traits[1].MaxValue)
+	}
+	if v, ok := traits[2].Value.Float64(); !ok || v != 1.5 || !traits[2].IsNumeric() {
+		t.Errorf("boost trait = %+v", traits[2])
+	}
+	if ts, ok := traits[3].Time(); !ok || !ts.Equal(time.Date(2019, 1, 1, 16, 40, 0, 0, time.UTC)) {
+		t.Errorf("date trait time = %s, %v", ts, ok)
+	}
+
+	out, err := json.Marshal(traits[1])
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"trait_type":"Level","value":5,"display_type":"number","max_value":10,"trait_count":0,"order":null}`
+	if string(out) != want {
+		t.Errorf("json.Marshal() = %s, want %s", out, want)
+	}
+}
+
+func TestComputeRarity(t *testing.T) {
+	assets := []Asset{
+		traitAsset(1, stringTrait("Background", "Blue"), stringTrait("Hat", "Crown")),
+		traitAsset(2, stringTrait("Background", "Blue"), stringTrait("Hat", "Cap")),
+		traitAsset(3, stringTrait("Background", "Blue")),
+		traitAsset(4, stringTrait("Background", "Red"), Trait{TraitType: "Level", Value: NumberTraitValue(3), DisplayType: TraitDisplayNumber}),
+	}
+
+	table := ComputeRarity(assets, RarityOptions{IncludeMissingTraits: true, IncludeTraitCount: true})
+
+	if table.Total != 4 {
+		t.Errorf("RarityTable.Total = %d, want 4", table.Total)
+	}
+	if f := table.Frequency("Background", "Blue"); f == nil || f.Count != 3 || f.Frequency != 0.75 {
+		t.Errorf("RarityTable.Frequency(Background, Blue) = %+v", f)
+	}
+	if f := table.Frequency("Hat", TraitValueNone); f == nil || f.Count != 2 {
+		t.Errorf("RarityTable.Frequency(Hat, None) = %+v", f)
+	}
+	if f := table.Frequency(TraitTypeTraitCount, "1"); f == nil || f.Count != 2 {
+		t.Errorf("RarityTable.Frequency(Trait Count, 1) = %+v", f)
+	}
+	if f := table.Frequency("Level", "3"); f != nil {
+		t.Errorf("RarityTable.Frequency(Level, 3) = %+v, numeric traits must be ignored", f)
+	}
+
+	wantHats := []TraitFrequency{
+		{TraitType: "Hat", Value: "Cap", Count: 1, Frequency: 0.25},
+		{TraitType: "Hat", Value: "Crown", Count: 1, Frequency: 0.25},
+		{TraitType: "Hat", Value: TraitValueNone, Count: 2, Frequency: 0.5},
+	}
+	if got := table.Frequencies("Hat"); !reflect.DeepEqual(got, wantHats) {
+		t.Errorf("RarityTable.Frequencies(Hat) = %+v, want %+v", got, wantHats)
+	}
+
+	// Asset 4 has the only red background, assets 1 and 2 a unique hat.
+	// Scores: 1 and 2: 4/3 + 4 + 2 = 7.33, 3: 4/3 + 2 + 2 = 5.33, 4: 4 + 2 + 2 = 8.
+	var (
+		ids        []int
+		scoreRanks []int
+	)
+	for _, r := range table.Assets {
+		ids = append(ids, r.Asset.ID)
+		scoreRanks = append(scoreRanks, r.RarityScoreRank)
+	}
+	if want := []int{4, 1, 2, 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("assets by rarity score = %v, want %v", ids, want)
+	}
+	if want := []int{1, 2, 2, 4}; !reflect.DeepEqual(scoreRanks, want) {
+		t.Errorf("rarity score ranks = %v, want %v", scoreRanks, want)
+	}
+	if got := table.Assets[0].RarityScore; math.Abs(got-8) > 1e-9 {
+		t.Errorf("rarity score of asset 4 = %f, want 8", got)
+	}
+
+	// Statistical rarity: 1 and 2: 0.75 * 0.25 * 0.5 = 0.09375, 3: 0.75 * 0.5 * 0.5 = 0.1875, 4: 0.25 * 0.5 * 0.5 = 0.0625.
+	ids = nil
+	for _, r := range table.ByStatisticalRarity() {
+		ids = append(ids, r.Asset.ID)
+	}
+	if want := []int{4, 1, 2, 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("assets by statistical rarity = %v, want %v", ids, want)
+	}
+}
+
+func TestComputeRarity_WithoutPseudoTraits(t *testing.T) {
+	assets := []Asset{
+		traitAsset(1, stringTrait("Hat", "Crown")),
+		traitAsset(2),
+	}
+	table := ComputeRarity(assets, RarityOptions{})
+
+	if f := table.Frequency("Hat", TraitValueNone); f != nil {
+		t.Errorf("RarityTable.Frequency(Hat, None) = %+v, want nil", f)
+	}
+	if len(table.Frequencies(TraitTypeTraitCount)) != 0 {
+		t.Errorf("trait count pseudo trait was added")
+	}
+	if table.Assets[0].Asset.ID != 1 || table.Assets[0].RarityScore != 2 {
+		t.Errorf("rarest asset = %+v", table.Assets[0])
+	}
+}
+
+func TestComputeRarity_SameTraitsShareRank(t *testing.T) {
+	traitsOf := func(j int) []Trait {
+		var traits []Trait
+		for k := 0; k < 10; k++ {
+			value := "b"
+			if j%(k+2) == 0 {
+				value = "a"
+			}
+			traits = append(traits, stringTrait(fmt.Sprintf("Trait %d", k), value))
+		}
+		return traits
+	}
+	assets := []Asset{traitAsset(1, traitsOf(0)...), traitAsset(2, traitsOf(0)...)}
+	for j := 1; j <= 30; j++ {
+		assets = append(assets, traitAsset(j+2, traitsOf(j)...))
+	}
+
+	for n := 0; n < 20; n++ {
+		table := ComputeRarity(assets, RarityOptions{IncludeTraitCount: true})
+		var a, b AssetRarity
+		for _, r := range table.Assets {
+			switch r.Asset.ID {
+			case 1:
+				a = r
+			case 2:
+				b = r
+			}
+		}
+		if a.RarityScore != b.RarityScore || a.RarityScoreRank != b.RarityScoreRank {
+			t.Fatalf("rarity score of identical assets = %v (#%d) and %v (#%d)", a.RarityScore, a.RarityScoreRank, b.RarityScore, b.RarityScoreRank)
+		}
+		if a.StatisticalRarity != b.StatisticalRarity || a.StatisticalRarityRank != b.StatisticalRarityRank {
+			t.Fatalf("statistical rarity of identical assets = %v (#%d) and %v (#%d)", a.StatisticalRarity, a.StatisticalRarityRank, b.StatisticalRarity, b.StatisticalRarityRank)
+		}
+	}
+}
+
+func TestComputeRarity_TraitCount(t *testing.T) {
+	assets := []Asset{
+		traitAsset(1, stringTrait("Hat", "None"), stringTrait("Eyes", "Blue")),
+		traitAsset(2, stringTrait("Eyes", "Blue")),
+	}
+	table := ComputeRarity(assets, RarityOptions{IncludeMissingTraits: true, IncludeTraitCount: true})
+
+	if f := table.Frequency(TraitTypeTraitCount, "2"); f == nil || f.Count != 1 {
+		t.Errorf("RarityTable.Frequency(Trait Count, 2) = %+v, want the asset with a real None value", f)
+	}
+	if f := table.Frequency(TraitTypeTraitCount, "1"); f == nil || f.Count != 1 {
+		t.Errorf("RarityTable.Frequency(Trait Count, 1) = %+v, want the asset missing Hat", f)
+	}
+}
+
+func TestComputeRarity_RepeatedTraitType(t *testing.T) {
+	assets := []Asset{
+		traitAsset(1, stringTrait("Accessory", "Earring"), stringTrait("Accessory", "Necklace"), stringTrait("Accessory", "Earring")),
+		traitAsset(2, stringTrait("Accessory", "Earring")),
+	}
+	table := ComputeRarity(assets, RarityOptions{IncludeTraitCount: true})
+
+	if f := table.Frequency("Accessory", "Earring"); f == nil || f.Count != 2 {
+		t.Errorf("RarityTable.Frequency(Accessory, Earring) = %+v, want count 2", f)
+	}
+	if f := table.Frequency("Accessory", "Necklace"); f == nil || f.Count != 1 {
+		t.Errorf("RarityTable.Frequency(Accessory, Necklace) = %+v, want count 1", f)
+	}
+	if f := table.Frequency(TraitTypeTraitCount, "2"); f == nil || f.Count != 1 {
+		t.Errorf("RarityTable.Frequency(Trait Count, 2) = %+v, want count 1", f)
+	}
+
+	var got []string
+	for _, r := range table.Assets {
+		if r.Asset.ID == 1 {
+			for _, f := range r.Traits {
+				got = append(got, f.TraitType+"="+f.Value)
+			}
+		}
+	}
+	want := []string{"Accessory=Earring", "Accessory=Necklace", "Trait Count=2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AssetRarity.Traits = %q, want %q", got, want)
+	}
+	if table.Assets[0].Asset.ID != 1 {
+		t.Errorf("rarest asset = %d, want 1", table.Assets[0].Asset.ID)
+	}
+}
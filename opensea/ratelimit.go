@@ -0,0 +1,111 @@
+package opensea
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket rate limiter. It is safe for concurrent use
+// and can be shared between several clients using the same API key.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a rate limiter allowing rps requests per second on
+// average with bursts of up to burst requests.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	// A done context must not consume a token.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	if err := sleepContext(ctx, delay); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait before it becomes available.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a token reserved by a caller that gave up waiting.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// waitRateLimit blocks until the request to path is allowed by both the
+// client-wide limiter and the limiter of the longest matching endpoint prefix.
+func (c *OpenSeaClient) waitRateLimit(ctx context.Context, path string) error {
+	if c.Limiter != nil {
+		if err := c.Limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	var (
+		limiter *RateLimiter
+		longest int
+	)
+	for prefix, l := range c.EndpointLimiters {
+		if strings.HasPrefix(path, prefix) && len(prefix) > longest {
+			limiter, longest = l, len(prefix)
+		}
+	}
+	if limiter != nil {
+		return limiter.Wait(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package opensea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	l := NewRateLimiter(100, 2)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Wait(context.Background()); err != nil {
+				t.Errorf("RateLimiter.Wait() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Two requests use the burst, the remaining four are spaced by 10ms.
+	if elapsed := time.Since(start); elapsed < time.Millisecond*35 {
+		t.Errorf("6 requests at 100 rps with burst 2 took %s, want at least 40ms", elapsed)
+	}
+}
+
+func TestRateLimiter_WaitCancel(t *testing.T) {
+	l := NewRateLimiter(1, 1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("RateLimiter.Wait() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Errorf("RateLimiter.Wait() error = %v, want %v", err, context.Canceled)
+	}
+	if l.tokens < -0.5 {
+		t.Errorf("RateLimiter tokens = %f after cancelled wait, want the reservation returned", l.tokens)
+	}
+}
+
+func TestRateLimiter_WaitCancelledAvailable(t *testing.T) {
+	l := NewRateLimiter(1, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Errorf("RateLimiter.Wait() error = %v, want %v", err, context.Canceled)
+	}
+	if l.tokens != 1 {
+		t.Errorf("RateLimiter tokens = %f after cancelled wait, want 1", l.tokens)
+	}
+}
+
+func TestOpenSeaClient_EndpointLimiters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"collection": {}}`))
+	}))
+	defer server.Close()
+
+	// A shared limiter that only allows a single request per hour.
+	shared := NewRateLimiter(1.0/3600, 1)
+	c := &OpenSeaClient{
+		Log:     zaptest.NewLogger(t).Sugar(),
+		client:  &http.Client{},
+		baseURL: server.URL,
+		EndpointLimiters: map[string]*RateLimiter{
+			"/api/v1/":           NewRateLimiter(1000, 10),
+			"/api/v1/collection": shared,
+		},
+	}
+
+	if _, err := c.GetCollection("boredapeyachtclub"); err != nil {
+		t.Fatalf("OpenSeaClient.GetCollection() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+	if _, err := c.GetCollectionContext(ctx, "boredapeyachtclub"); err != context.DeadlineExceeded {
+		t.Errorf("OpenSeaClient.GetCollectionContext() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := c.GetAssetsWithOffsetContext(ctx, "0x3b417FaeE9d2ff636701100891DC2755b5321Cc3", 0); err == context.DeadlineExceeded {
+		t.Errorf("OpenSeaClient.GetAssetsWithOffsetContext() was throttled by the collection limiter")
+	}
+}
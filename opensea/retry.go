@@ -0,0 +1,135 @@
+package opensea
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried by OpenSeaClient.Get.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseBackoff is the delay before the first retry. It doubles on every
+	// following attempt.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the exponential backoff. Zero means no cap. A
+	// Retry-After header sent by the server is honoured even if it is longer,
+	// up to MaxRetryAfter.
+	MaxBackoff time.Duration
+	// MaxRetryAfter is the longest Retry-After delay the client waits for.
+	// Requests asking for a longer delay fail with their APIError instead of
+	// being retried. Zero means no limit.
+	MaxRetryAfter time.Duration
+	// Jitter is the fraction, between 0 and 1, by which each backoff is
+	// randomly shortened to spread out concurrent retries.
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP status codes that are retried.
+	RetryableStatusCodes []int
+	// RetryNetworkErrors enables retrying requests that failed without a
+	// response, e.g. on connection resets or timeouts.
+	RetryNetworkErrors bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewOpenSeaClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:   4,
+		BaseBackoff:   time.Millisecond * 500,
+		MaxBackoff:    time.Second * 30,
+		MaxRetryAfter: time.Minute,
+		Jitter:        0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+	}
+}
+
+// retryDelay reports whether a request that failed with err on the given
+// attempt (starting at 1) should be retried, and how long to wait first.
+func (p RetryPolicy) retryDelay(attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if !p.isRetryableStatus(apiErr.StatusCode) {
+			return 0, false
+		}
+		if apiErr.RetryAfter > 0 {
+			if p.MaxRetryAfter > 0 && apiErr.RetryAfter > p.MaxRetryAfter {
+				return 0, false
+			}
+			return apiErr.RetryAfter, true
+		}
+		return p.backoff(attempt), true
+	}
+
+	if !p.RetryNetworkErrors {
+		return 0, false
+	}
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return 0, false
+	}
+	return p.backoff(attempt), true
+}
+
+func (p RetryPolicy) isRetryableStatus(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the exponential backoff before the retry following attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+	return d
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date. It returns zero if the header is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
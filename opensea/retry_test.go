@@ -0,0 +1,211 @@
+package opensea
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOpenSeaClient_GetRetry(t *testing.T) {
+	retry := RetryPolicy{
+		MaxAttempts:          3,
+		BaseBackoff:          time.Millisecond,
+		MaxBackoff:           time.Millisecond * 10,
+		MaxRetryAfter:        time.Second * 2,
+		Jitter:               0.5,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+	}
+	tests := []struct {
+		name         string
+		retry        RetryPolicy
+		statuses     []int
+		retryAfter   string
+		wantDelay    time.Duration
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			name:         "Succeeds after transient failures",
+			retry:        retry,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantAttempts: 3,
+		},
+		{
+			name:         "Honours Retry-After",
+			retry:        retry,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:   "1",
+			wantDelay:    time.Second,
+			wantAttempts: 2,
+		},
+		{
+			name:         "Gives up on a Retry-After above the limit",
+			retry:        retry,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:   "120",
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "Gives up after max attempts",
+			retry:        retry,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "Does not retry non-retryable status",
+			retry:        retry,
+			statuses:     []int{http.StatusNotFound, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "Zero policy disables retries",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&attempts, 1)
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.statuses[n-1])
+				w.Write([]byte(`{"collection": {"slug": "boredapeyachtclub"}}`))
+			}))
+			defer server.Close()
+
+			var delays []time.Duration
+			c := &OpenSeaClient{
+				Log:     zaptest.NewLogger(t).Sugar(),
+				Retry:   tt.retry,
+				client:  &http.Client{},
+				baseURL: server.URL,
+				sleep: func(ctx context.Context, d time.Duration) error {
+					delays = append(delays, d)
+					return ctx.Err()
+				},
+			}
+			got, err := c.GetCollection("boredapeyachtclub")
+			if tt.wantDelay != 0 && (len(delays) != 1 || delays[0] != tt.wantDelay) {
+				t.Errorf("OpenSeaClient.GetCollection() waited %v, want %s", delays, tt.wantDelay)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OpenSeaClient.GetCollection() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Slug != "boredapeyachtclub" {
+				t.Errorf("OpenSeaClient.GetCollection() slug = %s, want boredapeyachtclub", got.Slug)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+// roundTripFunc is an http.RoundTripper calling itself.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestOpenSeaClient_GetRetryNetworkError(t *testing.T) {
+	var attempts int32
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return nil, errors.New("connection reset by peer")
+	})
+
+	c := &OpenSeaClient{
+		Log: zaptest.NewLogger(t).Sugar(),
+		Retry: RetryPolicy{
+			MaxAttempts:        2,
+			BaseBackoff:        time.Millisecond,
+			RetryNetworkErrors: true,
+		},
+		client:  &http.Client{Transport: transport},
+		baseURL: "http://opensea.test",
+	}
+	if _, err := c.GetCollection("boredapeyachtclub"); err == nil {
+		t.Errorf("OpenSeaClient.GetCollection() expected an error for a failing transport")
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestRetryPolicy_RetryAfter(t *testing.T) {
+	p := RetryPolicy{
+		MaxAttempts:          3,
+		BaseBackoff:          time.Millisecond,
+		MaxBackoff:           time.Millisecond * 10,
+		MaxRetryAfter:        time.Minute,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests},
+	}
+	tests := []struct {
+		name       string
+		retryAfter time.Duration
+		wantDelay  time.Duration
+		wantRetry  bool
+	}{
+		{name: "Above max backoff", retryAfter: time.Second * 30, wantDelay: time.Second * 30, wantRetry: true},
+		{name: "At the limit", retryAfter: time.Minute, wantDelay: time.Minute, wantRetry: true},
+		{name: "Above the limit", retryAfter: time.Minute + time.Second, wantRetry: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: tt.retryAfter}
+			delay, ok := p.retryDelay(1, err)
+			if ok != tt.wantRetry || delay != tt.wantDelay {
+				t.Errorf("RetryPolicy.retryDelay() = %s, %v, want %s, %v", delay, ok, tt.wantDelay, tt.wantRetry)
+			}
+		})
+	}
+
+	p.MaxRetryAfter = 0
+	if delay, ok := p.retryDelay(1, &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Hour}); !ok || delay != time.Hour {
+		t.Errorf("RetryPolicy.retryDelay() without limit = %s, %v, want 1h0m0s, true", delay, ok)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 30, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"3", time.Second * 3},
+		{"-1", 0},
+		{"Sun, 30 Jan 2022 09:00:10 GMT", time.Second * 10},
+		{"Sun, 30 Jan 2022 08:59:00 GMT", 0},
+		{"soon", 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{BaseBackoff: time.Second, MaxBackoff: time.Second * 5}
+	want := []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 5, time.Second * 5}
+	for i, w := range want {
+		if got := p.backoff(i + 1); got != w {
+			t.Errorf("RetryPolicy.backoff(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+}
@@ -0,0 +1,477 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Chain is a blockchain supported by the OpenSea v2 API.
+type Chain string
+
+// Chains accepted by the Seaport orders endpoints.
+const (
+	ChainEthereum Chain = "ethereum"
+	ChainPolygon  Chain = "matic"
+	ChainKlaytn   Chain = "klaytn"
+	ChainGoerli   Chain = "goerli"
+	ChainMumbai   Chain = "mumbai"
+	ChainBaobab   Chain = "baobab"
+)
+
+// SeaportItemType is the kind of token of a Seaport offer or consideration
+// item.
+type SeaportItemType int
+
+// Item types defined by the Seaport protocol.
+const (
+	ItemTypeNative SeaportItemType = iota
+	ItemTypeERC20
+	ItemTypeERC721
+	ItemTypeERC1155
+	ItemTypeERC721WithCriteria
+	ItemTypeERC1155WithCriteria
+)
+
+// IsNFT reports whether items of type t are ERC-721 or ERC-1155 tokens.
+func (t SeaportItemType) IsNFT() bool {
+	return t >= ItemTypeERC721 && t <= ItemTypeERC1155WithCriteria
+}
+
+// SeaportOrderType is the Seaport order type, which sets whether an order
+// can be partially filled and whether its zone must approve fulfillments.
+type SeaportOrderType int
+
+// Order types defined by the Seaport protocol.
+const (
+	OrderTypeFullOpen SeaportOrderType = iota
+	OrderTypePartialOpen
+	OrderTypeFullRestricted
+	OrderTypePartialRestricted
+)
+
+// SeaportItem is an item given by the offerer of a Seaport order. Amounts
+// of NFTs are quantities without decimals and amounts of the native
+// currency have 18 decimals. The API does not tell the decimals of ERC-20
+// tokens, so their amounts are left without decimals until they are set
+// with SeaportOrder.SetPaymentTokens.
+type SeaportItem struct {
+	ItemType             SeaportItemType `json:"itemType"`
+	Token                Address         `json:"token"`
+	IdentifierOrCriteria TokenID         `json:"identifierOrCriteria"`
+	StartAmount          Price           `json:"startAmount"`
+	EndAmount            Price           `json:"endAmount"`
+}
+
+// SeaportConsiderationItem is an item received by Recipient when a Seaport
+// order is fulfilled.
+type SeaportConsiderationItem struct {
+	SeaportItem
+	Recipient Address `json:"recipient"`
+}
+
+// SeaportOrderParameters are the signed parameters of a Seaport order.
+type SeaportOrderParameters struct {
+	Offerer                         Address                    `json:"offerer"`
+	Offer                           []SeaportItem              `json:"offer"`
+	Consideration                   []SeaportConsiderationItem `json:"consideration"`
+	StartTime                       Timestamp                  `json:"startTime"`
+	EndTime                         Timestamp                  `json:"endTime"`
+	OrderType                       SeaportOrderType           `json:"orderType"`
+	Zone                            Address                    `json:"zone"`
+	ZoneHash                        string                     `json:"zoneHash"`
+	Salt                            string                     `json:"salt"`
+	ConduitKey                      string                     `json:"conduitKey"`
+	TotalOriginalConsiderationItems int                        `json:"totalOriginalConsiderationItems"`
+	Counter                         Decimal                    `json:"counter"`
+}
+
+// seaportOrderParameters has the fields of SeaportOrderParameters without
+// its methods.
+type seaportOrderParameters SeaportOrderParameters
+
+// UnmarshalJSON implements json.Unmarshaler. Amounts of NFT items are
+// quantities and, like those of ERC-20 items, take no decimals.
+func (p *SeaportOrderParameters) UnmarshalJSON(data []byte) error {
+	var v seaportOrderParameters
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*p = SeaportOrderParameters(v)
+	p.setDecimals(func(item SeaportItem) (int, bool) {
+		return 0, item.ItemType != ItemTypeNative
+	})
+	return nil
+}
+
+// setDecimals sets the decimals of the amounts of every item for which
+// decimals returns true.
+func (p *SeaportOrderParameters) setDecimals(decimals func(SeaportItem) (int, bool)) {
+	set := func(item *SeaportItem) {
+		if n, ok := decimals(*item); ok {
+			item.StartAmount.Decimals = n
+			item.EndAmount.Decimals = n
+		}
+	}
+	for i := range p.Offer {
+		set(&p.Offer[i])
+	}
+	for i := range p.Consideration {
+		set(&p.Consideration[i].SeaportItem)
+	}
+}
+
+// SeaportProtocolData is a Seaport order as passed to the Seaport contract.
+type SeaportProtocolData struct {
+	Parameters SeaportOrderParameters `json:"parameters"`
+	Signature  string                 `json:"signature"`
+}
+
+// SeaportFee is a fee paid to an account on fulfillment of an order.
+type SeaportFee struct {
+	Account     Account     `json:"account"`
+	BasisPoints BasisPoints `json:"basis_points"`
+}
+
+// Values of SeaportOrder.Side.
+const (
+	OrderSideAsk = "ask"
+	OrderSideBid = "bid"
+)
+
+// SeaportOrder represents a Seaport listing or offer on OpenSea.
+// https://docs.opensea.io/reference/seaport-orders
+type SeaportOrder struct {
+	CreatedDate     Timestamp           `json:"created_date"`
+	ClosingDate     Timestamp           `json:"closing_date"`
+	ListingTime     Timestamp           `json:"listing_time"`
+	ExpirationTime  Timestamp           `json:"expiration_time"`
+	OrderHash       string              `json:"order_hash"`
+	ProtocolData    SeaportProtocolData `json:"protocol_data"`
+	ProtocolAddress Address             `json:"protocol_address"`
+	Maker           *Account            `json:"maker"`
+	Taker           *Account            `json:"taker"`
+	CurrentPrice    Price               `json:"current_price"`
+	MakerFees       []SeaportFee        `json:"maker_fees"`
+	TakerFees       []SeaportFee        `json:"taker_fees"`
+	Side            string              `json:"side"`
+	OrderType       string              `json:"order_type"`
+	Cancelled       bool                `json:"cancelled"`
+	Finalized       bool                `json:"finalized"`
+	MarkedInvalid   bool                `json:"marked_invalid"`
+	ClientSignature string              `json:"client_signature"`
+	RelayID         string              `json:"relay_id"`
+}
+
+// seaportOrder has the fields of SeaportOrder without its methods.
+type seaportOrder SeaportOrder
+
+// UnmarshalJSON implements json.Unmarshaler. CurrentPrice is in the
+// currency of the order and, like its items, takes no decimals when the
+// currency is an ERC-20 token.
+func (o *SeaportOrder) UnmarshalJSON(data []byte) error {
+	var v seaportOrder
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = SeaportOrder(v)
+	if currency, ok := o.currency(); ok && currency.ItemType == ItemTypeERC20 {
+		o.CurrentPrice.Decimals = 0
+	}
+	return nil
+}
+
+// SetPaymentTokens sets the decimals of the ERC-20 amounts of o, including
+// CurrentPrice, from the payment tokens with the same address, such as
+// those of Collection.PaymentTokens. Amounts of other tokens are unchanged.
+func (o *SeaportOrder) SetPaymentTokens(tokens ...PaymentToken) {
+	decimals := func(item SeaportItem) (int, bool) {
+		if item.ItemType != ItemTypeERC20 {
+			return 0, false
+		}
+		for _, token := range tokens {
+			if token.Address.Equal(item.Token) && token.Decimals != nil {
+				return *token.Decimals, true
+			}
+		}
+		return 0, false
+	}
+	o.ProtocolData.Parameters.setDecimals(decimals)
+	if currency, ok := o.currency(); ok {
+		if n, ok := decimals(currency); ok {
+			o.CurrentPrice.Decimals = n
+		}
+	}
+}
+
+// currency returns the first item of the order that is not an NFT: the
+// price asked by a listing or offered by an offer.
+func (o SeaportOrder) currency() (SeaportItem, bool) {
+	params := o.ProtocolData.Parameters
+	for _, item := range params.Offer {
+		if !item.ItemType.IsNFT() {
+			return item, true
+		}
+	}
+	for _, item := range params.Consideration {
+		if !item.ItemType.IsNFT() {
+			return item.SeaportItem, true
+		}
+	}
+	return SeaportItem{}, false
+}
+
+// NFTs returns the NFT items traded by the order: those offered by a
+// listing or those requested for the offerer by an offer.
+func (o SeaportOrder) NFTs() []SeaportItem {
+	params := o.ProtocolData.Parameters
+	var items []SeaportItem
+	for _, item := range params.Offer {
+		if item.ItemType.IsNFT() {
+			items = append(items, item)
+		}
+	}
+	for _, item := range params.Consideration {
+		if item.ItemType.IsNFT() && item.Recipient.Equal(params.Offerer) {
+			items = append(items, item.SeaportItem)
+		}
+	}
+	return items
+}
+
+// GetSeaportOrdersResponse is a page of Seaport listings or offers.
+type GetSeaportOrdersResponse struct {
+	Next     string         `json:"next"`
+	Previous string         `json:"previous"`
+	Orders   []SeaportOrder `json:"orders"`
+}
+
+// Values accepted by SeaportOrdersQuery.OrderBy.
+const (
+	SeaportOrderByCreatedDate = "created_date"
+	SeaportOrderByEthPrice    = "eth_price"
+)
+
+// SeaportOrdersQuery holds the filters for retrieving Seaport listings and
+// offers. Empty fields are not sent to the API.
+// https://docs.opensea.io/reference/retrieve-listings
+type SeaportOrdersQuery struct {
+	AssetContractAddress Address
+	// TokenIDs requires AssetContractAddress to be set.
+	TokenIDs       []TokenID
+	Maker          Address
+	Taker          Address
+	OrderBy        string
+	OrderDirection string
+	ListedAfter    time.Time
+	ListedBefore   time.Time
+	// Limit is the page size. Zero uses the page size of the client.
+	Limit int
+	// Cursor is the position of the page to request, taken from the Next or
+	// Previous field of a previous response.
+	Cursor string
+}
+
+// Validate checks the query for invalid values and unsupported combinations.
+func (q SeaportOrdersQuery) Validate() error {
+	if q.AssetContractAddress != "" {
+		if err := validateAddressParam("asset_contract_address", q.AssetContractAddress); err != nil {
+			return err
+		}
+	}
+	if q.Maker != "" {
+		if err := validateAddressParam("maker", q.Maker); err != nil {
+			return err
+		}
+	}
+	if q.Taker != "" {
+		if err := validateAddressParam("taker", q.Taker); err != nil {
+			return err
+		}
+	}
+	if len(q.TokenIDs) > 0 && q.AssetContractAddress == "" {
+		return &ValidationError{Field: "token_ids", Message: "requires asset_contract_address"}
+	}
+	for _, id := range q.TokenIDs {
+		if !id.IsSet() {
+			return &ValidationError{Field: "token_ids", Message: "must not contain unset token IDs"}
+		}
+	}
+	if len(q.TokenIDs) > maxPageSize {
+		return &ValidationError{Field: "token_ids", Message: fmt.Sprintf("at most %d token IDs can be requested at once", maxPageSize)}
+	}
+	switch q.OrderBy {
+	case "", SeaportOrderByCreatedDate:
+	case SeaportOrderByEthPrice:
+		if q.AssetContractAddress == "" || len(q.TokenIDs) == 0 {
+			return &ValidationError{Field: "order_by", Message: "eth_price requires asset_contract_address and token_ids"}
+		}
+	default:
+		return &ValidationError{Field: "order_by", Message: fmt.Sprintf("unsupported value %q", q.OrderBy)}
+	}
+	switch q.OrderDirection {
+	case "", OrderDirectionAsc, OrderDirectionDesc:
+	default:
+		return &ValidationError{Field: "order_direction", Message: fmt.Sprintf("unsupported value %q", q.OrderDirection)}
+	}
+	if !q.ListedBefore.IsZero() && !q.ListedAfter.IsZero() && !q.ListedAfter.Before(q.ListedBefore) {
+		return &ValidationError{Field: "listed_after", Message: "must be before listed_before"}
+	}
+	if q.Limit < 0 || q.Limit > maxPageSize {
+		return &ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d, or 0 for the page size of the client", maxPageSize)}
+	}
+	return nil
+}
+
+// values encodes the query, using limit when q.Limit is not set.
+func (q SeaportOrdersQuery) values(limit int) url.Values {
+	v := url.Values{}
+	if q.AssetContractAddress != "" {
+		v.Set("asset_contract_address", q.AssetContractAddress.String())
+	}
+	for _, id := range q.TokenIDs {
+		v.Add("token_ids", id.String())
+	}
+	if q.Maker != "" {
+		v.Set("maker", q.Maker.String())
+	}
+	if q.Taker != "" {
+		v.Set("taker", q.Taker.String())
+	}
+	if q.OrderBy != "" {
+		v.Set("order_by", q.OrderBy)
+	}
+	if q.OrderDirection != "" {
+		v.Set("order_direction", q.OrderDirection)
+	}
+	if !q.ListedAfter.IsZero() {
+		v.Set("listed_after", fmt.Sprint(q.ListedAfter.Unix()))
+	}
+	if !q.ListedBefore.IsZero() {
+		v.Set("listed_before", fmt.Sprint(q.ListedBefore.Unix()))
+	}
+	if q.Limit > 0 {
+		limit = q.Limit
+	}
+	v.Set("limit", fmt.Sprint(limit))
+	if q.Cursor != "" {
+		v.Set("cursor", q.Cursor)
+	}
+	return v
+}
+
+// Kinds of Seaport orders, used in the endpoint path.
+const (
+	seaportListings = "listings"
+	seaportOffers   = "offers"
+)
+
+// GetListings gets a single page of the Seaport listings on chain matching
+// the query, newest first.
+// https://docs.opensea.io/reference/retrieve-listings
+func (c *OpenSeaClient) GetListings(chain Chain, query SeaportOrdersQuery) (GetSeaportOrdersResponse, error) {
+	return c.GetListingsContext(context.Background(), chain, query)
+}
+
+// GetListingsContext is like GetListings but bound to ctx.
+func (c *OpenSeaClient) GetListingsContext(ctx context.Context, chain Chain, query SeaportOrdersQuery) (GetSeaportOrdersResponse, error) {
+	return c.getSeaportOrders(ctx, seaportListings, chain, query)
+}
+
+// GetOffers gets a single page of the Seaport offers on chain matching the
+// query, newest first.
+// https://docs.opensea.io/reference/retrieve-offers
+func (c *OpenSeaClient) GetOffers(chain Chain, query SeaportOrdersQuery) (GetSeaportOrdersResponse, error) {
+	return c.GetOffersContext(context.Background(), chain, query)
+}
+
+// GetOffersContext is like GetOffers but bound to ctx.
+func (c *OpenSeaClient) GetOffersContext(ctx context.Context, chain Chain, query SeaportOrdersQuery) (GetSeaportOrdersResponse, error) {
+	return c.getSeaportOrders(ctx, seaportOffers, chain, query)
+}
+
+// getSeaportOrders gets a page of Seaport orders of the given kind.
+func (c *OpenSeaClient) getSeaportOrders(ctx context.Context, kind string, chain Chain, query SeaportOrdersQuery) (GetSeaportOrdersResponse, error) {
+	var osResp GetSeaportOrdersResponse
+	if err := validateChain(chain); err != nil {
+		c.logf(EventError, "Error validating chain: %s", err)
+		return osResp, err
+	}
+	if err := query.Validate(); err != nil {
+		c.logf(EventError, "Error validating query: %s", err)
+		return osResp, err
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/v2/orders/%s/seaport/%s", c.baseURL, chain, kind))
+	if err != nil {
+		c.logf(EventError, "Error parsing url: %s", err)
+		return osResp, err
+	}
+
+	// Set query params
+	u.RawQuery = query.values(c.limitAssets).Encode()
+
+	resp, err := c.GetContext(ctx, u)
+	if err != nil {
+		c.logf(EventError, "Error getting %s: %s", kind, err)
+		return osResp, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&osResp)
+	if err != nil {
+		c.logf(EventError, "Error decoding response: %s", err)
+		return osResp, err
+	}
+
+	return osResp, nil
+}
+
+// GetAllListings returns all Seaport listings on chain matching the query,
+// following the pages from query.Cursor on.
+func (c *OpenSeaClient) GetAllListings(chain Chain, query SeaportOrdersQuery) ([]SeaportOrder, error) {
+	return c.GetAllListingsContext(context.Background(), chain, query)
+}
+
+// GetAllListingsContext is like GetAllListings but bound to ctx.
+func (c *OpenSeaClient) GetAllListingsContext(ctx context.Context, chain Chain, query SeaportOrdersQuery) ([]SeaportOrder, error) {
+	return c.getAllSeaportOrders(ctx, c.NewListingsPaginator(chain, query))
+}
+
+// GetAllOffers returns all Seaport offers on chain matching the query,
+// following the pages from query.Cursor on.
+func (c *OpenSeaClient) GetAllOffers(chain Chain, query SeaportOrdersQuery) ([]SeaportOrder, error) {
+	return c.GetAllOffersContext(context.Background(), chain, query)
+}
+
+// GetAllOffersContext is like GetAllOffers but bound to ctx.
+func (c *OpenSeaClient) GetAllOffersContext(ctx context.Context, chain Chain, query SeaportOrdersQuery) ([]SeaportOrder, error) {
+	return c.getAllSeaportOrders(ctx, c.NewOffersPaginator(chain, query))
+}
+
+// getAllSeaportOrders collects the orders of every page of p.
+func (c *OpenSeaClient) getAllSeaportOrders(ctx context.Context, p *SeaportOrdersPaginator) ([]SeaportOrder, error) {
+	var allOrders []SeaportOrder
+
+	for p.HasNextPage() {
+		resp, err := p.NextPage(ctx)
+		if err != nil {
+			return allOrders, err
+		}
+
+		allOrders = append(allOrders, resp.Orders...)
+	}
+
+	return allOrders, nil
+}
+
+// validateChain checks that chain is supported by the Seaport endpoints.
+func validateChain(chain Chain) error {
+	switch chain {
+	case ChainEthereum, ChainPolygon, ChainKlaytn, ChainGoerli, ChainMumbai, ChainBaobab:
+		return nil
+	}
+	return &ValidationError{Field: "chain", Message: fmt.Sprintf("unsupported value %q", chain)}
+}
@@ -0,0 +1,342 @@
+package opensea
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOpenSeaClient_GetListings(t *testing.T) {
+	type args struct {
+		chain Chain
+		query SeaportOrdersQuery
+	}
+	tests := []struct {
+		name        string
+		args        args
+		path        string
+		rawQuery    string
+		fixturePath string
+		want        GetSeaportOrdersResponse
+		wantErr     bool
+	}{
+		{
+			name: "Get listings of a token",
+			args: args{
+				chain: ChainEthereum,
+				query: SeaportOrdersQuery{
+					AssetContractAddress: "0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D",
+					TokenIDs:             []TokenID{MustParseTokenID("7090")},
+					OrderBy:              SeaportOrderByEthPrice,
+					OrderDirection:       OrderDirectionAsc,
+				},
+			},
+			path:        "/v2/orders/ethereum/seaport/listings",
+			rawQuery:    "asset_contract_address=0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d&limit=50&order_by=eth_price&order_direction=asc&token_ids=7090",
+			fixturePath: "../testdata/get_listings.json",
+			want:        FixtureGetListingsResp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("Expected to request '%s', got: %s", tt.path, r.URL.Path)
+				}
+				if r.URL.RawQuery != tt.rawQuery {
+					t.Errorf("Expected query '%s', got: %s", tt.rawQuery, r.URL.RawQuery)
+				}
+				if r.Header.Get("X-API-KEY") != "secret" {
+					t.Errorf("Expected X-API-KEY header, got: %s", r.Header.Get("X-API-KEY"))
+				}
+				w.WriteHeader(http.StatusOK)
+
+				// Read the fixture
+				jsonFile, err := os.Open(tt.fixturePath)
+				if err != nil {
+					t.Errorf("Failed to open fixture file: %s", err)
+				}
+				defer jsonFile.Close()
+
+				// Write the fixture to the response
+				_, err = io.Copy(w, jsonFile)
+				if err != nil {
+					t.Errorf("Failed to write fixture to response: %s", err)
+				}
+			}))
+			defer server.Close()
+
+			c := &OpenSeaClient{
+				Log:         zaptest.NewLogger(t).Sugar(),
+				apiKey:      "secret",
+				client:      &http.Client{},
+				baseURL:     server.URL,
+				limitAssets: 50,
+			}
+			got, err := c.GetListings(tt.args.chain, tt.args.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OpenSeaClient.GetListings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OpenSeaClient.GetListings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeaportOrder_Listing(t *testing.T) {
+	order := FixtureGetListingsResp.Orders[0]
+
+	nfts := order.NFTs()
+	if len(nfts) != 1 || nfts[0].IdentifierOrCriteria.String() != "7090" || nfts[0].StartAmount.String() != "1" {
+		t.Errorf("SeaportOrder.NFTs() = %+v, want token 7090", nfts)
+	}
+
+	// The listing pays each maker fee from the current price.
+	params := order.ProtocolData.Parameters
+	for i, fee := range order.MakerFees {
+		item := params.Consideration[i+1]
+		if !item.Recipient.Equal(fee.Account.Address) {
+			t.Errorf("Consideration[%d].Recipient = %s, want %s", i+1, item.Recipient, fee.Account.Address)
+		}
+		if got := order.CurrentPrice.Fee(fee.BasisPoints); got.Cmp(item.StartAmount) != 0 {
+			t.Errorf("Price.Fee(%s) = %s, want %s", fee.BasisPoints, got, item.StartAmount)
+		}
+	}
+	if !params.EndTime.Equal(order.ExpirationTime.Time) {
+		t.Errorf("SeaportOrderParameters.EndTime = %s, want %s", params.EndTime, order.ExpirationTime)
+	}
+
+	data, err := json.Marshal(order)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var got SeaportOrder
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, order) {
+		t.Errorf("round trip of listing = %+v, want %+v", got, order)
+	}
+}
+
+func TestOpenSeaClient_GetOffers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want := "/v2/orders/matic/seaport/offers"; r.URL.Path != want {
+			t.Errorf("Expected to request '%s', got: %s", want, r.URL.Path)
+		}
+		w.Write([]byte(`{"next": null, "previous": null, "orders": [{
+			"order_hash": "0x01",
+			"side": "bid",
+			"current_price": "50000000000000000",
+			"protocol_data": {"parameters": {
+				"offerer": "0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+				"offer": [{"itemType": 1, "token": "0x7ceb23fd6bc0add59e62ac25578270cff1b9f619", "identifierOrCriteria": "0", "startAmount": "50000000000000000", "endAmount": "50000000000000000"}],
+				"consideration": [
+					{"itemType": 3, "token": "0x2953399124f0cbb46d2cbacd8a89cf0599974963", "identifierOrCriteria": "42", "startAmount": "2", "endAmount": "2", "recipient": "0x409753d7a885abdc28ff470dfa82bc448b683bf4"},
+					{"itemType": 1, "token": "0x7ceb23fd6bc0add59e62ac25578270cff1b9f619", "identifierOrCriteria": "0", "startAmount": "1250000000000000", "endAmount": "1250000000000000", "recipient": "0x0000a26b00c1f0df003000390027140000faa719"}
+				],
+				"startTime": "1662033600",
+				"endTime": "1662638400",
+				"orderType": 0,
+				"counter": "3"
+			}, "signature": null}
+		}]}`))
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 50,
+	}
+	got, err := c.GetOffers(ChainPolygon, SeaportOrdersQuery{Maker: "0x409753d7a885abdc28ff470dfa82bc448b683bf4"})
+	if err != nil {
+		t.Fatalf("OpenSeaClient.GetOffers() error = %v", err)
+	}
+	if len(got.Orders) != 1 || got.Orders[0].Side != OrderSideBid {
+		t.Fatalf("OpenSeaClient.GetOffers() = %+v, want one bid", got)
+	}
+
+	order := got.Orders[0]
+	nfts := order.NFTs()
+	if len(nfts) != 1 || nfts[0].ItemType != ItemTypeERC1155 || nfts[0].StartAmount.Decimals != 0 {
+		t.Fatalf("SeaportOrder.NFTs() = %+v, want one ERC-1155 item", nfts)
+	}
+	if got := nfts[0].StartAmount.String(); got != "2" {
+		t.Errorf("SeaportItem.StartAmount = %s, want 2", got)
+	}
+	if got := order.ProtocolData.Parameters.Offer[0].StartAmount.Decimals; got != 0 {
+		t.Errorf("SeaportItem.StartAmount.Decimals = %d before SetPaymentTokens, want 0", got)
+	}
+	order.SetPaymentTokens(PaymentToken{Symbol: "WETH", Address: "0x7ceb23fd6bc0add59e62ac25578270cff1b9f619", Decimals: intPtr(18)})
+	if got := order.ProtocolData.Parameters.Offer[0].StartAmount.Format(2); got != "0.05" {
+		t.Errorf("SeaportItem.StartAmount.Format() = %s, want 0.05", got)
+	}
+	if got := order.ProtocolData.Parameters.Consideration[1].StartAmount.String(); got != "0.00125" {
+		t.Errorf("fee SeaportItem.StartAmount = %s, want 0.00125", got)
+	}
+	if got := order.CurrentPrice.String(); got != "0.05" {
+		t.Errorf("SeaportOrder.CurrentPrice = %s, want 0.05", got)
+	}
+	if order.NFTs()[0].StartAmount.Decimals != 0 {
+		t.Errorf("SetPaymentTokens() changed the decimals of NFT amounts")
+	}
+	if got := order.ProtocolData.Parameters.Counter; got != "3" {
+		t.Errorf("SeaportOrderParameters.Counter = %s, want 3", got)
+	}
+}
+
+func TestSeaportOrder_SetPaymentTokens(t *testing.T) {
+	var order SeaportOrder
+	data := `{"side": "ask", "current_price": "1500000", "protocol_data": {"parameters": {
+		"offer": [{"itemType": 2, "token": "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", "identifierOrCriteria": "7090", "startAmount": "1", "endAmount": "1"}],
+		"consideration": [{"itemType": 1, "token": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", "identifierOrCriteria": "0", "startAmount": "1500000", "endAmount": "1500000"}]
+	}}}`
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if order.CurrentPrice.Decimals != 0 {
+		t.Errorf("SeaportOrder.CurrentPrice.Decimals = %d, want 0 for an ERC-20 price", order.CurrentPrice.Decimals)
+	}
+
+	order.SetPaymentTokens(FixtureGetCollectionResp.PaymentTokens...)
+	if got := order.CurrentPrice.String(); got != "1.5" {
+		t.Errorf("SeaportOrder.CurrentPrice = %s, want 1.5 USDC", got)
+	}
+	if got := order.ProtocolData.Parameters.Consideration[0].EndAmount.String(); got != "1.5" {
+		t.Errorf("SeaportItem.EndAmount = %s, want 1.5 USDC", got)
+	}
+}
+
+func TestOpenSeaClient_GetAllListings(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"orders": [{"order_hash": "0x03"}, {"order_hash": "0x02"}], "next": "page2", "previous": null}`))
+		case "page2":
+			w.Write([]byte(`{"orders": [{"order_hash": "0x01"}], "next": null, "previous": "page1"}`))
+		default:
+			t.Errorf("Unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     server.URL,
+		limitAssets: 2,
+		pagination:  PaginationOffset,
+	}
+	got, err := c.GetAllListings(ChainEthereum, SeaportOrdersQuery{Maker: "0x409753d7a885abdc28ff470dfa82bc448b683bf4"})
+	if err != nil {
+		t.Fatalf("OpenSeaClient.GetAllListings() error = %v", err)
+	}
+	var hashes []string
+	for _, order := range got {
+		hashes = append(hashes, order.OrderHash)
+	}
+	if want := []string{"0x03", "0x02", "0x01"}; !reflect.DeepEqual(hashes, want) {
+		t.Errorf("OpenSeaClient.GetAllListings() returned %v, want %v", hashes, want)
+	}
+	want := []string{
+		"limit=2&maker=0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+		"cursor=page2&limit=2&maker=0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("requested %q, want %q", queries, want)
+	}
+}
+
+func TestSeaportOrdersQuery_Values(t *testing.T) {
+	query := SeaportOrdersQuery{
+		AssetContractAddress: "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d",
+		TokenIDs:             []TokenID{TokenIDFromUint64(1), TokenIDFromUint64(2)},
+		Taker:                "0x409753d7a885abdc28ff470dfa82bc448b683bf4",
+		ListedAfter:          time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC),
+		ListedBefore:         time.Date(2022, 9, 2, 0, 0, 0, 0, time.UTC),
+		Limit:                20,
+		Cursor:               "page2",
+	}
+	if err := query.Validate(); err != nil {
+		t.Fatalf("SeaportOrdersQuery.Validate() error = %v", err)
+	}
+	want := "asset_contract_address=0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d&cursor=page2&limit=20&listed_after=1661990400&listed_before=1662076800&taker=0x409753d7a885abdc28ff470dfa82bc448b683bf4&token_ids=1&token_ids=2"
+	if got := query.values(50).Encode(); got != want {
+		t.Errorf("SeaportOrdersQuery.values() = %s, want %s", got, want)
+	}
+}
+
+func TestSeaportOrdersQuery_Validate(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		query     SeaportOrdersQuery
+		wantField string
+	}{
+		{
+			name:      "Token IDs without contract",
+			query:     SeaportOrdersQuery{TokenIDs: []TokenID{TokenIDFromUint64(1)}},
+			wantField: "token_ids",
+		},
+		{
+			name:      "Price order without tokens",
+			query:     SeaportOrdersQuery{OrderBy: SeaportOrderByEthPrice},
+			wantField: "order_by",
+		},
+		{
+			name:      "Invalid maker",
+			query:     SeaportOrdersQuery{Maker: "0x1234"},
+			wantField: "maker",
+		},
+		{
+			name:      "Empty time range",
+			query:     SeaportOrdersQuery{ListedAfter: now, ListedBefore: now},
+			wantField: "listed_after",
+		},
+		{
+			name:      "Limit too large",
+			query:     SeaportOrdersQuery{Limit: 100},
+			wantField: "limit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("SeaportOrdersQuery.Validate() error = %v, want *ValidationError", err)
+			}
+			if validationErr.Field != tt.wantField {
+				t.Errorf("ValidationError.Field = %s, want %s", validationErr.Field, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestOpenSeaClient_GetListings_InvalidChain(t *testing.T) {
+	c := &OpenSeaClient{
+		Log:         zaptest.NewLogger(t).Sugar(),
+		client:      &http.Client{},
+		baseURL:     "http://127.0.0.1:0",
+		limitAssets: 50,
+	}
+	_, err := c.GetListings("solana", SeaportOrdersQuery{})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "chain" {
+		t.Errorf("OpenSeaClient.GetListings() error = %v, want a chain *ValidationError", err)
+	}
+}
@@ -0,0 +1,190 @@
+package opensea
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StatsSnapshot holds the stats of a collection at a point in time.
+type StatsSnapshot struct {
+	Slug  string
+	Time  time.Time
+	Stats CollectionStats
+}
+
+// SnapshotStore persists stats snapshots.
+type SnapshotStore interface {
+	// SaveSnapshot stores a snapshot.
+	SaveSnapshot(ctx context.Context, snapshot StatsSnapshot) error
+	// Snapshots returns the snapshots of slug taken between from and to,
+	// both inclusive, sorted by time.
+	Snapshots(ctx context.Context, slug string, from, to time.Time) ([]StatsSnapshot, error)
+}
+
+// MemorySnapshotStore is a SnapshotStore keeping snapshots in memory. It is
+// safe for concurrent use.
+type MemorySnapshotStore struct {
+	mu        sync.Mutex
+	snapshots map[string][]StatsSnapshot
+}
+
+// NewMemorySnapshotStore creates an empty MemorySnapshotStore.
+func NewMemorySnapshotStore() *MemorySnapshotStore {
+	return &MemorySnapshotStore{
+		snapshots: make(map[string][]StatsSnapshot),
+	}
+}
+
+// SaveSnapshot implements SnapshotStore.
+func (s *MemorySnapshotStore) SaveSnapshot(ctx context.Context, snapshot StatsSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshots := append(s.snapshots[snapshot.Slug], snapshot)
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+	s.snapshots[snapshot.Slug] = snapshots
+	return nil
+}
+
+// Snapshots implements SnapshotStore.
+func (s *MemorySnapshotStore) Snapshots(ctx context.Context, slug string, from, to time.Time) ([]StatsSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []StatsSnapshot
+	for _, snapshot := range s.snapshots[slug] {
+		if snapshot.Time.Before(from) || snapshot.Time.After(to) {
+			continue
+		}
+		out = append(out, snapshot)
+	}
+	return out, nil
+}
+
+// StatsDelta is the change of the stats of a collection between two snapshots.
+type StatsDelta struct {
+	Slug string
+	From time.Time
+	To   time.Time
+
+	FloorPriceChange float64
+	// FloorPriceChangePercent is zero when the earlier floor price is zero.
+	FloorPriceChangePercent float64
+	TotalVolumeChange       float64
+	TotalSalesChange        float64
+	// NumOwnersChange is the net number of owners gained or lost.
+	NumOwnersChange int
+	// NumOwnersChangePercent is NumOwnersChange relative to the earlier
+	// number of owners. Being a net change, it does not measure churn. It is
+	// zero when the earlier number of owners is zero.
+	NumOwnersChangePercent float64
+}
+
+// SnapshotDelta computes the change between two snapshots of a collection.
+func SnapshotDelta(from, to StatsSnapshot) StatsDelta {
+	d := StatsDelta{
+		Slug:              to.Slug,
+		From:              from.Time,
+		To:                to.Time,
+		FloorPriceChange:  to.Stats.FloorPrice - from.Stats.FloorPrice,
+		TotalVolumeChange: to.Stats.TotalVolume - from.Stats.TotalVolume,
+		TotalSalesChange:  to.Stats.TotalSales - from.Stats.TotalSales,
+		NumOwnersChange:   to.Stats.NumOwners - from.Stats.NumOwners,
+	}
+	if from.Stats.FloorPrice != 0 {
+		d.FloorPriceChangePercent = d.FloorPriceChange / from.Stats.FloorPrice * 100
+	}
+	if from.Stats.NumOwners != 0 {
+		d.NumOwnersChangePercent = float64(d.NumOwnersChange) / float64(from.Stats.NumOwners) * 100
+	}
+	return d
+}
+
+// StatsRecorder periodically records the stats of a set of collections.
+type StatsRecorder struct {
+	client   *OpenSeaClient
+	store    SnapshotStore
+	interval time.Duration
+	slugs    []string
+	now      func() time.Time
+}
+
+// NewStatsRecorder creates a recorder capturing the stats of slugs into
+// store every interval. The interval must be positive.
+func NewStatsRecorder(c *OpenSeaClient, store SnapshotStore, interval time.Duration, slugs ...string) (*StatsRecorder, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("opensea: invalid stats interval %s: must be positive", interval)
+	}
+	return &StatsRecorder{
+		client:   c,
+		store:    store,
+		interval: interval,
+		slugs:    slugs,
+		now:      time.Now,
+	}, nil
+}
+
+// RecordOnce captures a snapshot of every collection. A failing collection
+// does not prevent the others from being recorded; the first error is
+// returned.
+func (r *StatsRecorder) RecordOnce(ctx context.Context) error {
+	var firstErr error
+	for _, slug := range r.slugs {
+		stats, err := r.client.GetCollectionStatsContext(ctx, slug)
+		if err == nil {
+			err = r.store.SaveSnapshot(ctx, StatsSnapshot{
+				Slug:  slug,
+				Time:  r.now().UTC(),
+				Stats: stats,
+			})
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			r.client.logf(EventError, "Error recording stats of %s: %s", slug, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// Run records snapshots immediately and then every interval until ctx is
+// done. Errors of single rounds are logged and do not stop the recorder.
+func (r *StatsRecorder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.RecordOnce(ctx); err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Delta returns the change of the stats of slug between the first snapshot
+// taken at or after from and the last one taken at or before to. It returns
+// false if there are fewer than two snapshots in that range.
+func (r *StatsRecorder) Delta(ctx context.Context, slug string, from, to time.Time) (StatsDelta, bool, error) {
+	snapshots, err := r.store.Snapshots(ctx, slug, from, to)
+	if err != nil {
+		return StatsDelta{}, false, err
+	}
+	if len(snapshots) < 2 {
+		return StatsDelta{}, false, nil
+	}
+	return SnapshotDelta(snapshots[0], snapshots[len(snapshots)-1]), true, nil
+}
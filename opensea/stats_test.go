@@ -0,0 +1,137 @@
+package opensea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSnapshotDelta(t *testing.T) {
+	from := StatsSnapshot{
+		Slug:  "boredapeyachtclub",
+		Time:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		Stats: CollectionStats{FloorPrice: 80, TotalVolume: 400000, TotalSales: 20000, NumOwners: 6400},
+	}
+	to := StatsSnapshot{
+		Slug:  "boredapeyachtclub",
+		Time:  time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+		Stats: CollectionStats{FloorPrice: 100, TotalVolume: 401000, TotalSales: 20010, NumOwners: 6336},
+	}
+
+	want := StatsDelta{
+		Slug:                    "boredapeyachtclub",
+		From:                    from.Time,
+		To:                      to.Time,
+		FloorPriceChange:        20,
+		FloorPriceChangePercent: 25,
+		TotalVolumeChange:       1000,
+		TotalSalesChange:        10,
+		NumOwnersChange:         -64,
+		NumOwnersChangePercent:  -1,
+	}
+	if got := SnapshotDelta(from, to); got != want {
+		t.Errorf("SnapshotDelta() = %+v, want %+v", got, want)
+	}
+
+	if got := SnapshotDelta(StatsSnapshot{}, to); got.FloorPriceChangePercent != 0 || got.NumOwnersChangePercent != 0 {
+		t.Errorf("SnapshotDelta() from empty stats = %+v, want zero percentages", got)
+	}
+}
+
+func TestStatsRecorder(t *testing.T) {
+	floor := 80
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/collection/boredapeyachtclub/stats":
+			fmt.Fprintf(w, `{"stats": {"floor_price": %d, "num_owners": 6400}}`, floor)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:     zaptest.NewLogger(t).Sugar(),
+		client:  &http.Client{},
+		baseURL: server.URL,
+	}
+	store := NewMemorySnapshotStore()
+	r, err := NewStatsRecorder(c, store, time.Hour, "boredapeyachtclub", "missing")
+	if err != nil {
+		t.Fatalf("NewStatsRecorder() error = %v", err)
+	}
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	r.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	if err := r.RecordOnce(ctx); !IsNotFound(err) {
+		t.Errorf("StatsRecorder.RecordOnce() error = %v, want not found", err)
+	}
+	floor, now = 100, now.Add(time.Hour)
+	r.RecordOnce(ctx)
+
+	snapshots, err := store.Snapshots(ctx, "boredapeyachtclub", start, now)
+	if err != nil {
+		t.Fatalf("MemorySnapshotStore.Snapshots() error = %v", err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("recorded %d snapshots, want 2", len(snapshots))
+	}
+
+	delta, ok, err := r.Delta(ctx, "boredapeyachtclub", start, now)
+	if err != nil || !ok {
+		t.Fatalf("StatsRecorder.Delta() = %v, %v", ok, err)
+	}
+	if delta.FloorPriceChange != 20 || delta.From != start || delta.To != now {
+		t.Errorf("StatsRecorder.Delta() = %+v", delta)
+	}
+
+	if _, ok, _ := r.Delta(ctx, "boredapeyachtclub", start, start); ok {
+		t.Errorf("StatsRecorder.Delta() with a single snapshot should not be ok")
+	}
+}
+
+func TestStatsRecorder_Run(t *testing.T) {
+	requests := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- struct{}{}
+		w.Write([]byte(`{"stats": {"floor_price": 80}}`))
+	}))
+	defer server.Close()
+
+	c := &OpenSeaClient{
+		Log:     zaptest.NewLogger(t).Sugar(),
+		client:  &http.Client{},
+		baseURL: server.URL,
+	}
+	r, err := NewStatsRecorder(c, NewMemorySnapshotStore(), time.Millisecond*10, "boredapeyachtclub")
+	if err != nil {
+		t.Fatalf("NewStatsRecorder() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.Run(ctx) }()
+
+	<-requests
+	<-requests
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("StatsRecorder.Run() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestNewStatsRecorder_InvalidInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if _, err := NewStatsRecorder(&OpenSeaClient{}, NewMemorySnapshotStore(), interval); err == nil {
+			t.Errorf("NewStatsRecorder(%s) should fail", interval)
+		}
+	}
+}
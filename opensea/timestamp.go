@@ -0,0 +1,179 @@
+package opensea
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layouts marking timestamps given in unix seconds, as a JSON number or as
+// a numeric string.
+const (
+	unixLayout       = "unix"
+	unixStringLayout = "unix_string"
+)
+
+// minUnixDigits is the number of digits from which a numeric string is read
+// as unix seconds, so that a year such as "2022" is not. Nine digits cover
+// every time since 1973; "0" is accepted as the unset time.
+const minUnixDigits = 9
+
+// Timestamp is a point in time returned by the OpenSea API. OpenSea mixes
+// several formats: ISO 8601 dates without timezone, with or without
+// microseconds, and unix seconds. All of them are parsed as UTC.
+//
+// A Timestamp remembers the format and UTC offset it was parsed from and
+// marshals back to them. The zero value is unset and marshals as null.
+type Timestamp struct {
+	time.Time
+	layout string
+	// offset is the UTC offset in seconds the timestamp was given in.
+	offset int
+}
+
+// ParseTimestamp parses an ISO 8601 date, with or without fractional
+// seconds and timezone, or a decimal number of unix seconds of at least
+// nine digits.
+func ParseTimestamp(s string) (Timestamp, error) {
+	if (s == "0" || len(s) >= minUnixDigits) && strings.Trim(s, "0123456789") == "" {
+		secs, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return Timestamp{}, fmt.Errorf("opensea: invalid timestamp %q", s)
+		}
+		t := UnixTimestamp(secs)
+		t.layout = unixStringLayout
+		return t, nil
+	}
+
+	layout := timestampLayout(s)
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("opensea: invalid timestamp %q", s)
+	}
+	_, offset := t.Zone()
+	return Timestamp{Time: t.UTC(), layout: layout, offset: offset}, nil
+}
+
+// MustParseTimestamp is like ParseTimestamp but panics on error.
+func MustParseTimestamp(s string) Timestamp {
+	t, err := ParseTimestamp(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// UnixTimestamp returns the timestamp of secs unix seconds. OpenSea uses 0
+// for a missing order time, so 0 returns a timestamp whose Time is zero but
+// that still marshals as 0.
+func UnixTimestamp(secs int64) Timestamp {
+	if secs == 0 {
+		return Timestamp{layout: unixLayout}
+	}
+	return Timestamp{Time: time.Unix(secs, 0).UTC(), layout: unixLayout}
+}
+
+// NewTimestamp returns t in UTC, marshaled as RFC 3339.
+func NewTimestamp(t time.Time) Timestamp {
+	if t.IsZero() {
+		return Timestamp{}
+	}
+	return Timestamp{Time: t.UTC(), layout: time.RFC3339Nano}
+}
+
+// timestampLayout returns the layout of an ISO 8601 date, keeping the number
+// of fractional digits and the form of the timezone so that formatting
+// reproduces s.
+func timestampLayout(s string) string {
+	if len(s) == len("2006-01-02") {
+		return "2006-01-02"
+	}
+	layout := "2006-01-02T15:04:05"
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		n := 0
+		for _, c := range s[i+1:] {
+			if c < '0' || c > '9' {
+				break
+			}
+			n++
+		}
+		layout += "." + strings.Repeat("0", n)
+	}
+	switch {
+	case strings.HasSuffix(s, "Z"):
+		layout += "Z07:00"
+	case len(s) >= len(layout)+6 && strings.ContainsAny(s[len(s)-6:len(s)-5], "+-") && s[len(s)-3] == ':':
+		layout += "-07:00"
+	}
+	return layout
+}
+
+// String returns t in its original format, or an empty string if t is unset.
+func (t Timestamp) String() string {
+	switch {
+	case t.layout == unixLayout || t.layout == unixStringLayout:
+		return strconv.FormatInt(t.unix(), 10)
+	case t.Time.IsZero():
+		return ""
+	case t.layout == "":
+		return t.Time.Format(time.RFC3339Nano)
+	case t.offset != 0:
+		return t.Time.In(time.FixedZone("", t.offset)).Format(t.layout)
+	}
+	return t.Time.Format(t.layout)
+}
+
+// unix returns the unix seconds of t, 0 for the zero time.
+func (t Timestamp) unix() int64 {
+	if t.Time.IsZero() {
+		return 0
+	}
+	return t.Time.Unix()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts dates as strings and
+// unix seconds as numbers; null and an empty string leave t unset.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+	if len(data) > 0 && data[0] != '"' {
+		secs, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("opensea: invalid timestamp %s", data)
+		}
+		*t = UnixTimestamp(secs)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = Timestamp{}
+		return nil
+	}
+	v, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Timestamps are encoded in their
+// original format, unix seconds given as numbers again as numbers; an unset
+// timestamp encodes as null.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	switch {
+	case t.layout == unixLayout:
+		return []byte(strconv.FormatInt(t.unix(), 10)), nil
+	case t.Time.IsZero() && t.layout != unixStringLayout:
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
@@ -0,0 +1,102 @@
+package opensea
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Microseconds", s: "2021-10-29T06:19:31.787110", want: time.Date(2021, 10, 29, 6, 19, 31, 787110000, time.UTC)},
+		{name: "Seconds", s: "2022-01-30T09:09:59", want: time.Date(2022, 1, 30, 9, 9, 59, 0, time.UTC)},
+		{name: "UTC", s: "2022-01-30T09:09:59Z", want: time.Date(2022, 1, 30, 9, 9, 59, 0, time.UTC)},
+		{name: "Offset", s: "2022-01-30T11:09:59.5+02:00", want: time.Date(2022, 1, 30, 9, 9, 59, 500000000, time.UTC)},
+		{name: "Date", s: "2022-01-30", want: time.Date(2022, 1, 30, 0, 0, 0, 0, time.UTC)},
+		{name: "Unix seconds", s: "1643533799", want: time.Date(2022, 1, 30, 9, 9, 59, 0, time.UTC)},
+		{name: "Year", s: "2022", wantErr: true},
+		{name: "Empty", s: "", wantErr: true},
+		{name: "Invalid", s: "2022-01-30T09:09", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTimestamp(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTimestamp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("ParseTimestamp() = %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestamp_JSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		want     string
+		wantZero bool
+	}{
+		{name: "Microseconds", data: `"2021-10-29T06:19:31.787110"`},
+		{name: "Seconds", data: `"2022-03-20T18:30:00"`},
+		{name: "UTC", data: `"2022-03-20T18:30:00.120Z"`},
+		{name: "Zero offset", data: `"2022-01-30T09:09:59+00:00"`},
+		{name: "Offset", data: `"2022-01-30T11:09:59.5+02:00"`},
+		{name: "Negative offset", data: `"2022-01-30T04:09:59-05:00"`},
+		{name: "Unix seconds", data: `1643533799`},
+		{name: "Unix seconds string", data: `"1643533799"`},
+		{name: "Unix zero string", data: `"0"`, wantZero: true},
+		{name: "Unix zero", data: `0`, wantZero: true},
+		{name: "Null", data: `null`, wantZero: true},
+		{name: "Empty string", data: `""`, want: `null`, wantZero: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ts Timestamp
+			if err := json.Unmarshal([]byte(tt.data), &ts); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if ts.IsZero() != tt.wantZero {
+				t.Errorf("Timestamp.IsZero() = %v, want %v", ts.IsZero(), tt.wantZero)
+			}
+			got, err := json.Marshal(ts)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			want := tt.want
+			if want == "" {
+				want = tt.data
+			}
+			if string(got) != want {
+				t.Errorf("json.Marshal() = %s, want %s", got, want)
+			}
+		})
+	}
+
+	var ts Timestamp
+	if err := json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Error("json.Unmarshal() of an invalid timestamp should fail")
+	}
+}
+
+func TestTimestamp_Order(t *testing.T) {
+	order := FixtureGetOrdersResp.Orders[0]
+	if !order.ListingTime.Before(order.ExpirationTime.Time) {
+		t.Errorf("Order.ListingTime %s is not before ExpirationTime %s", order.ListingTime, order.ExpirationTime)
+	}
+	if !order.ClosingDate.Equal(order.ExpirationTime.Time) {
+		t.Errorf("Order.ClosingDate %s != ExpirationTime %s", order.ClosingDate, order.ExpirationTime)
+	}
+	if got := NewTimestamp(order.ListingTime.Time).String(); got != "2022-01-27T09:09:10Z" {
+		t.Errorf("NewTimestamp().String() = %s", got)
+	}
+}
@@ -0,0 +1,212 @@
+package opensea
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// Contracts of the OpenSea shared storefront, whose token IDs pack the
+// creator, an index and the max supply.
+const (
+	SharedStorefrontAddress        Address = "0x495f947276749ce646f68ac8c248420045cb7b5e"
+	PolygonSharedStorefrontAddress Address = "0x2953399124f0cbb46d2cbacd8a89cf0599974963"
+)
+
+// IsSharedStorefront reports whether contract is an OpenSea shared
+// storefront contract.
+func IsSharedStorefront(contract Address) bool {
+	return contract.Equal(SharedStorefrontAddress) || contract.Equal(PolygonSharedStorefrontAddress)
+}
+
+// maxTokenID is 2^256 - 1, the largest ERC-721 and ERC-1155 token ID.
+var maxTokenID = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// TokenID is the ID of a token within its contract, an unsigned 256-bit
+// integer. The zero value is unset, which is different from token ID 0.
+type TokenID struct {
+	i *big.Int
+}
+
+// ParseTokenID parses a decimal token ID or a hex token ID with 0x prefix.
+func ParseTokenID(s string) (TokenID, error) {
+	i, ok := new(big.Int), false
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		_, ok = i.SetString(s[2:], 16)
+	} else {
+		_, ok = i.SetString(s, 10)
+	}
+	if !ok || strings.ContainsAny(s, "+-") {
+		return TokenID{}, fmt.Errorf("opensea: invalid token ID %q", s)
+	}
+	if i.Cmp(maxTokenID) > 0 {
+		return TokenID{}, fmt.Errorf("opensea: invalid token ID %q: exceeds 256 bits", s)
+	}
+	return TokenID{i: normalizeInt(i)}, nil
+}
+
+// MustParseTokenID is like ParseTokenID but panics on error.
+func MustParseTokenID(s string) TokenID {
+	t, err := ParseTokenID(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// NewTokenID returns the token ID i. It panics if i is negative or exceeds
+// 256 bits.
+func NewTokenID(i *big.Int) TokenID {
+	if i.Sign() < 0 || i.Cmp(maxTokenID) > 0 {
+		panic(fmt.Sprintf("opensea: token ID %s out of range", i))
+	}
+	return TokenID{i: normalizeInt(new(big.Int).Set(i))}
+}
+
+// TokenIDFromUint64 returns the token ID n.
+func TokenIDFromUint64(n uint64) TokenID {
+	return TokenID{i: normalizeInt(new(big.Int).SetUint64(n))}
+}
+
+// IsSet reports whether t holds a token ID.
+func (t TokenID) IsSet() bool {
+	return t.i != nil
+}
+
+// BigInt returns a copy of the value of t, or nil if t is unset.
+func (t TokenID) BigInt() *big.Int {
+	if t.i == nil {
+		return nil
+	}
+	return new(big.Int).Set(t.i)
+}
+
+// Cmp compares t and u, returning -1, 0 or +1. Unset token IDs sort first.
+func (t TokenID) Cmp(u TokenID) int {
+	switch {
+	case t.i == nil && u.i == nil:
+		return 0
+	case t.i == nil:
+		return -1
+	case u.i == nil:
+		return 1
+	}
+	return t.i.Cmp(u.i)
+}
+
+// Equal reports whether t and u are the same token ID.
+func (t TokenID) Equal(u TokenID) bool {
+	return t.Cmp(u) == 0
+}
+
+// String returns t in decimal, the form used by the OpenSea API, or an
+// empty string if t is unset.
+func (t TokenID) String() string {
+	if t.i == nil {
+		return ""
+	}
+	return t.i.String()
+}
+
+// Hex returns t in hex with 0x prefix, or an empty string if t is unset.
+func (t TokenID) Hex() string {
+	if t.i == nil {
+		return ""
+	}
+	return "0x" + t.i.Text(16)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts strings and
+// numbers; null leaves the token ID unset.
+func (t *TokenID) UnmarshalJSON(data []byte) error {
+	s, err := unquoteNumber(data)
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		*t = TokenID{}
+		return nil
+	}
+	v, err := ParseTokenID(s)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The token ID is encoded as a
+// decimal string; an unset token ID encodes as null.
+func (t TokenID) MarshalJSON() ([]byte, error) {
+	if t.i == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.i.String())
+}
+
+// SortTokenIDs sorts ids in ascending order.
+func SortTokenIDs(ids []TokenID) {
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Cmp(ids[j]) < 0
+	})
+}
+
+// SharedStorefrontToken is the content of a token ID minted on the OpenSea
+// shared storefront: the top 160 bits hold the creator, the next 56 bits an
+// index and the low 40 bits the max supply.
+type SharedStorefrontToken struct {
+	Creator   Address
+	Index     uint64
+	MaxSupply uint64
+}
+
+const (
+	storefrontSupplyBits = 40
+	storefrontIndexBits  = 56
+)
+
+// SharedStorefront decodes t as a shared storefront token ID. It returns
+// false if t is unset. Token IDs of other contracts decode to meaningless
+// values, see IsSharedStorefront.
+func (t TokenID) SharedStorefront() (SharedStorefrontToken, bool) {
+	if t.i == nil {
+		return SharedStorefrontToken{}, false
+	}
+	mask := func(bits uint) *big.Int {
+		return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+	}
+	supply := new(big.Int).And(t.i, mask(storefrontSupplyBits))
+	index := new(big.Int).Rsh(t.i, storefrontSupplyBits)
+	index.And(index, mask(storefrontIndexBits))
+	creator := new(big.Int).Rsh(t.i, storefrontSupplyBits+storefrontIndexBits)
+
+	return SharedStorefrontToken{
+		Creator:   Address(fmt.Sprintf("0x%040x", creator)),
+		Index:     index.Uint64(),
+		MaxSupply: supply.Uint64(),
+	}, true
+}
+
+// TokenID packs s into a shared storefront token ID. It fails if the
+// creator is invalid or the index or max supply do not fit their bits.
+func (s SharedStorefrontToken) TokenID() (TokenID, error) {
+	creator, err := ParseAddress(string(s.Creator))
+	if err != nil {
+		return TokenID{}, err
+	}
+	if s.Index >= 1<<storefrontIndexBits {
+		return TokenID{}, fmt.Errorf("opensea: shared storefront index %d exceeds %d bits", s.Index, storefrontIndexBits)
+	}
+	if s.MaxSupply >= 1<<storefrontSupplyBits {
+		return TokenID{}, fmt.Errorf("opensea: shared storefront max supply %d exceeds %d bits", s.MaxSupply, storefrontSupplyBits)
+	}
+
+	i, _ := new(big.Int).SetString(string(creator[2:]), 16)
+	i.Lsh(i, storefrontIndexBits)
+	i.Or(i, new(big.Int).SetUint64(s.Index))
+	i.Lsh(i, storefrontSupplyBits)
+	i.Or(i, new(big.Int).SetUint64(s.MaxSupply))
+	return TokenID{i: normalizeInt(i)}, nil
+}
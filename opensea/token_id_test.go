@@ -0,0 +1,118 @@
+package opensea
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseTokenID(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{name: "Decimal", s: "7090", want: "7090"},
+		{name: "Zero", s: "0", want: "0"},
+		{name: "Hex", s: "0x1bb2", want: "7090"},
+		{name: "Shared storefront", s: "0x6da705478b5c1fd9cac9e664015c0db98bd1a3a0000000000000030000000384", want: "49597200392958280165177755798765298064312831948909620000388784438348531893124"},
+		{name: "Largest", s: "0x" + "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", want: "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+		{name: "Exceeds 256 bits", s: "0x1" + "0000000000000000000000000000000000000000000000000000000000000000", wantErr: true},
+		{name: "Negative", s: "-1", wantErr: true},
+		{name: "Signed hex", s: "0x+1", wantErr: true},
+		{name: "Empty", s: "", wantErr: true},
+		{name: "Not a number", s: "12a", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTokenID(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTokenID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseTokenID() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenID_Order(t *testing.T) {
+	ids := []TokenID{
+		MustParseTokenID("49597200392958280165177755798765298064312831948909620000388784438348531893124"),
+		TokenIDFromUint64(10),
+		{},
+		MustParseTokenID("0x9"),
+		TokenIDFromUint64(0),
+	}
+	SortTokenIDs(ids)
+
+	var got []string
+	for _, id := range ids {
+		got = append(got, id.String())
+	}
+	want := []string{"", "0", "9", "10", "49597200392958280165177755798765298064312831948909620000388784438348531893124"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortTokenIDs() = %q, want %q", got, want)
+	}
+	if !MustParseTokenID("0xa").Equal(TokenIDFromUint64(10)) {
+		t.Error("TokenID.Equal() of hex and decimal IDs = false, want true")
+	}
+}
+
+func TestTokenID_JSON(t *testing.T) {
+	var asset Asset
+	if err := json.Unmarshal([]byte(`{"token_id": "49597200392958280165177755798765298064312831948909620000388784438348531893124"}`), &asset); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	data, err := json.Marshal(asset.TokenID)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `"49597200392958280165177755798765298064312831948909620000388784438348531893124"`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var id TokenID
+	if err := json.Unmarshal([]byte(`7090`), &id); err != nil || id.String() != "7090" {
+		t.Errorf("json.Unmarshal() of a number = %s, %v", id, err)
+	}
+	if err := json.Unmarshal([]byte(`null`), &id); err != nil || id.IsSet() {
+		t.Errorf("json.Unmarshal() of null = %s, %v, want unset", id, err)
+	}
+	if data, _ := json.Marshal(TokenID{}); string(data) != "null" {
+		t.Errorf("json.Marshal() of an unset token ID = %s, want null", data)
+	}
+}
+
+func TestTokenID_SharedStorefront(t *testing.T) {
+	order := FixtureGetOrdersResp.Orders[0]
+	if !IsSharedStorefront(order.Metadata.Asset.Address) {
+		t.Fatalf("IsSharedStorefront(%s) = false, want true", order.Metadata.Asset.Address)
+	}
+
+	got, ok := order.Metadata.Asset.ID.SharedStorefront()
+	if !ok {
+		t.Fatal("TokenID.SharedStorefront() returned false")
+	}
+	want := SharedStorefrontToken{
+		Creator:   "0x6da705478b5c1fd9cac9e664015c0db98bd1a3a0",
+		Index:     3,
+		MaxSupply: 900,
+	}
+	if got != want {
+		t.Errorf("TokenID.SharedStorefront() = %+v, want %+v", got, want)
+	}
+
+	id, err := want.TokenID()
+	if err != nil {
+		t.Fatalf("SharedStorefrontToken.TokenID() error = %v", err)
+	}
+	if !id.Equal(order.Metadata.Asset.ID) {
+		t.Errorf("SharedStorefrontToken.TokenID() = %s, want %s", id, order.Metadata.Asset.ID)
+	}
+
+	if _, err := (SharedStorefrontToken{Creator: want.Creator, MaxSupply: 1 << 40}).TokenID(); err == nil {
+		t.Error("SharedStorefrontToken.TokenID() with an oversized max supply should fail")
+	}
+}
@@ -0,0 +1,129 @@
+package opensea
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Values of Trait.DisplayType. Traits without a display type are shown as
+// strings.
+const (
+	TraitDisplayString          = ""
+	TraitDisplayNumber          = "number"
+	TraitDisplayBoostNumber     = "boost_number"
+	TraitDisplayBoostPercentage = "boost_percentage"
+	TraitDisplayDate            = "date"
+)
+
+// Trait represents a property of an asset.
+// https://docs.opensea.io/docs/metadata-standards#attributes
+type Trait struct {
+	TraitType   string     `json:"trait_type"`
+	Value       TraitValue `json:"value"`
+	DisplayType string     `json:"display_type"`
+	MaxValue    TraitValue `json:"max_value"`
+	TraitCount  int        `json:"trait_count"`
+	Order       *int       `json:"order"`
+}
+
+// IsNumeric reports whether the trait is displayed as a number or a boost.
+func (t Trait) IsNumeric() bool {
+	switch t.DisplayType {
+	case TraitDisplayNumber, TraitDisplayBoostNumber, TraitDisplayBoostPercentage:
+		return true
+	}
+	return false
+}
+
+// Time returns the value of a date trait, given in unix seconds.
+func (t Trait) Time() (time.Time, bool) {
+	if t.DisplayType != TraitDisplayDate {
+		return time.Time{}, false
+	}
+	secs, ok := t.Value.Float64()
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(secs), 0).UTC(), true
+}
+
+// TraitValue is a trait value, which OpenSea encodes either as a string or
+// as a number. The zero value represents a missing value.
+type TraitValue struct {
+	raw      string
+	isNumber bool
+	isSet    bool
+}
+
+// StringTraitValue returns a TraitValue holding s.
+func StringTraitValue(s string) TraitValue {
+	return TraitValue{raw: s, isSet: true}
+}
+
+// NumberTraitValue returns a TraitValue holding f.
+func NumberTraitValue(f float64) TraitValue {
+	return TraitValue{raw: strconv.FormatFloat(f, 'f', -1, 64), isNumber: true, isSet: true}
+}
+
+// IsNumber reports whether the value was encoded as a number.
+func (v TraitValue) IsNumber() bool {
+	return v.isNumber
+}
+
+// IsZero reports whether the value is missing.
+func (v TraitValue) IsZero() bool {
+	return !v.isSet
+}
+
+// String returns the value as text, formatting numbers as they were encoded.
+func (v TraitValue) String() string {
+	return v.raw
+}
+
+// Float64 returns the value as a number. Strings holding a number are
+// converted too.
+func (v TraitValue) Float64() (float64, bool) {
+	if !v.isSet {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(v.raw, 64)
+	return f, err == nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *TraitValue) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*v = TraitValue{}
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*v = StringTraitValue(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*v = TraitValue{raw: n.String(), isNumber: true, isSet: true}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v TraitValue) MarshalJSON() ([]byte, error) {
+	switch {
+	case !v.isSet:
+		return []byte("null"), nil
+	case v.isNumber:
+		return []byte(v.raw), nil
+	default:
+		return json.Marshal(v.raw)
+	}
+}